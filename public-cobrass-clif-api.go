@@ -21,6 +21,10 @@ type (
 	// using the value's String() method.
 	ThirdPartyOptionValue = clif.ThirdPartyOptionValue
 
+	// ThirdPartyOptionValues the option values of a flag that may be
+	// specified multiple times.
+	ThirdPartyOptionValues = clif.ThirdPartyOptionValues
+
 	// ChangedFlagsMap represents the set of third party flags
 	// presented by the user on the command line.
 	// (NB: Cobra does not currently have a mechanism to collect third
@@ -32,6 +36,18 @@ type (
 	// but not in ThirdPartyCommandLine.
 	ChangedFlagsMap = clif.ChangedFlagsMap
 
+	// MultiValueFlagsMap is the multi valued form of ChangedFlagsMap, in
+	// which a flag may have multiple option values.
+	MultiValueFlagsMap = clif.MultiValueFlagsMap
+
+	// ThirdPartyFlagOccurrence is a single occurrence of a flag along with
+	// its option value.
+	ThirdPartyFlagOccurrence = clif.ThirdPartyFlagOccurrence
+
+	// OrderedFlags are the occurrences of the flags in the order in which
+	// the user specified them (see EvaluateOrdered).
+	OrderedFlags = clif.OrderedFlags
+
 	// ThirdPartyChangedFlags (see ChangedFlagsMap)
 	ThirdPartyChangedFlags = clif.ThirdPartyChangedFlags
 
@@ -61,6 +77,113 @@ type (
 	// entry paradigm that need to delegate an invocation to an
 	// external third party command.
 	ExternalThirdParty = clif.ExternalThirdParty
+
+	// OutputStyle determines the form of the options in the command line
+	// composed by Evaluate.
+	OutputStyle = clif.OutputStyle
+
+	// EvaluateOptions options that determine how Evaluate composes the
+	// command line.
+	EvaluateOptions = clif.EvaluateOptions
+
+	// EvaluateOptionFn definition of a client defined function to set
+	// EvaluateOptions.
+	EvaluateOptionFn = clif.EvaluateOptionFn
+
+	// ThirdPartyLayer is a named source of flags for a third party command,
+	// eg defaults, profile config, scheme config, environment or the command
+	// line (see EvaluateLayers).
+	ThirdPartyLayer = clif.ThirdPartyLayer
+
+	// TokenProvenance identifies where a token in the command line composed
+	// by EvaluateLayers came from.
+	TokenProvenance = clif.TokenProvenance
+
+	// LayeredCommandLine is the command line composed by EvaluateLayers,
+	// along with the provenance of each of its tokens.
+	LayeredCommandLine = clif.LayeredCommandLine
+
+	// Ordering determines the order of the flags in the command line
+	// composed by Evaluate.
+	Ordering = clif.Ordering
+
+	// FlagKind describes how a third party flag is used on the command line.
+	FlagKind = clif.FlagKind
+
+	// ThirdPartySchema describes the flags of a third party command, keyed
+	// by their bare long name, so that Evaluate can pair flags with their
+	// values based on knowledge of the third party command.
+	ThirdPartySchema = clif.ThirdPartySchema
+
+	// ThirdPartyFlagSpec describes a flag of a third party command, ie its
+	// kind, the type of its option value and the values it may take.
+	ThirdPartyFlagSpec = clif.ThirdPartyFlagSpec
+
+	// ValueType is the type of the option value of a third party flag.
+	ValueType = clif.ValueType
+
+	// MergePolicy determines how the option values of a specified flag are
+	// combined with those of its occurrences in the secondary command line.
+	MergePolicy = clif.MergePolicy
+)
+
+const (
+	// SeparateOutputStyle the flag and its option value are separate
+	// tokens, eg "--gaussian-blur 0.05". This is the default.
+	SeparateOutputStyle = clif.SeparateOutputStyle
+
+	// JoinedOutputStyle the flag and its option value are joined into a
+	// single token, eg "--gaussian-blur=0.05" and "-b0.05".
+	JoinedOutputStyle = clif.JoinedOutputStyle
+
+	// GroupedOrdering the specified flags are followed by the remaining
+	// flags and positional arguments of secondary. This is the default.
+	GroupedOrdering = clif.GroupedOrdering
+
+	// PreservedOrdering the remaining flags and positional arguments of
+	// secondary retain their original positions.
+	PreservedOrdering = clif.PreservedOrdering
+
+	// UndefinedFlagKind the flag is not described by the schema
+	UndefinedFlagKind = clif.UndefinedFlagKind
+
+	// SwitchFlagKind the flag does not take a value
+	SwitchFlagKind = clif.SwitchFlagKind
+
+	// ValueFlagKind the flag takes a value
+	ValueFlagKind = clif.ValueFlagKind
+
+	// RepeatableFlagKind the flag takes a value and may occur multiple times
+	RepeatableFlagKind = clif.RepeatableFlagKind
+
+	// AnyValueType the option value is not checked
+	AnyValueType = clif.AnyValueType
+
+	// IntValueType the option value is a signed integer
+	IntValueType = clif.IntValueType
+
+	// UintValueType the option value is an unsigned integer
+	UintValueType = clif.UintValueType
+
+	// FloatValueType the option value is a floating point number
+	FloatValueType = clif.FloatValueType
+
+	// BoolValueType the option value is a boolean
+	BoolValueType = clif.BoolValueType
+
+	// DurationValueType the option value is a duration
+	DurationValueType = clif.DurationValueType
+
+	// ReplaceMergePolicy the specified values replace those in secondary
+	ReplaceMergePolicy = clif.ReplaceMergePolicy
+
+	// AppendMergePolicy the specified values are appended to those in
+	// secondary
+	AppendMergePolicy = clif.AppendMergePolicy
+
+	// UnionMergePolicy the specified values are appended to those in
+	// secondary, less any duplicates
+	UnionMergePolicy = clif.UnionMergePolicy
 )
 
 var (
@@ -73,6 +196,21 @@ var (
 	// contains flags in their bare long form (bare as in without dash prefix).
 	Evaluate = clif.Evaluate
 
+	// EvaluateMulti is the multi valued form of Evaluate, in which each of
+	// the specified flags may have multiple option values, combined with
+	// those in secondary according to the merge policy of the flag.
+	EvaluateMulti = clif.EvaluateMulti
+
+	// EvaluateOrdered is the form of EvaluateMulti in which the specified
+	// flags are in the order the user specified them, which is preserved
+	// in the resulting command line.
+	EvaluateOrdered = clif.EvaluateOrdered
+
+	// EvaluateLayers merges the layers, which are in ascending order of
+	// precedence, recording the layer each token of the resulting command
+	// line came from.
+	EvaluateLayers = clif.EvaluateLayers
+
 	// Expand returns a slice of strings representing the positional arguments and
 	// flags/options to be executed by the third party program. before and flags
 	// are represented as a ThirdPartyCommandLine. This means that they can be
@@ -81,4 +219,9 @@ var (
 	// flags would be specified in the flags parameter. after is optional and
 	// again represents further positional arguments.
 	Expand = clif.Expand
+
+	// Redact returns a copy of the command line suitable for display, in
+	// which the values of the sensitive flags, specified by their bare long
+	// or short names, are replaced by a redacted value.
+	Redact = clif.Redact
 )
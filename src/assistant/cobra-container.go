@@ -3,7 +3,6 @@ package assistant
 import (
 	"reflect"
 
-	"github.com/snivilised/cobrass/src/internal/third/lo"
 	"github.com/spf13/cobra"
 
 	"github.com/snivilised/cobrass/src/assistant/locale"
@@ -23,9 +22,19 @@ type commandsCollection map[string]*cobra.Command
 // CobraContainer is a wrapper around the collection of cobra commands.
 // Please see unit tests for examples of how to use the CobraContainer.
 type CobraContainer struct {
-	root      *cobra.Command
-	commands  commandsCollection
-	paramSets paramSetsCollection
+	root        *cobra.Command
+	commands    commandsCollection
+	paramSets   paramSetsCollection
+	middleware  []Middleware
+	subtrees    middlewareCollection
+	originals   map[*cobra.Command]RunE
+	exitCodes   ExitCodes
+	renderer    ErrorRenderer
+	renderers   map[ErrorFormat]ErrorRenderer
+	errorFormat string
+	applied     bool
+	lazy        lazyCommandsCollection
+	classifying bool
 }
 
 // NewCobraContainer is a factory function for the CobraContainer. The client
@@ -37,6 +46,14 @@ func NewCobraContainer(root *cobra.Command) *CobraContainer {
 		root:      root,
 		commands:  make(commandsCollection),
 		paramSets: make(paramSetsCollection),
+		subtrees:  make(middlewareCollection),
+		originals: make(map[*cobra.Command]RunE),
+		exitCodes: defaultExitCodes(),
+		renderer:  TextErrorRenderer{},
+		renderers: map[ErrorFormat]ErrorRenderer{
+			JSONErrorFormat: JSONErrorRenderer{},
+		},
+		lazy: make(lazyCommandsCollection),
 	}
 }
 
@@ -112,14 +129,17 @@ func (container *CobraContainer) Root() *cobra.Command {
 // calling the Name() function on the cobra command.
 //
 // Returns the command identified by the name, nil if the command does not exist.
+// If the command was registered lazily, it is materialised.
 func (container *CobraContainer) Command(name string) *cobra.Command {
 	if name == container.root.Name() {
 		return container.Root()
 	}
 
-	command, exists := container.commands[name]
+	if _, exists := container.commands[name]; !exists {
+		return nil
+	}
 
-	return lo.Ternary(exists, command, nil)
+	return container.materialise(name)
 }
 
 // MustRegisterParamSet stores the parameter set under the provided name. Used
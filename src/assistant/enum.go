@@ -2,6 +2,7 @@ package assistant
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -53,6 +54,13 @@ import (
 // is what you get by default using the %v formatter for print functions.
 type AcceptableEnumValues[E ~int] map[E][]string
 
+// EnumDescriptor provides a type agnostic view of the acceptable values of
+// an enum, so that they can be reported independently of the underlying int
+// based enum type, eg in a manifest. EnumInfo is an EnumDescriptor.
+type EnumDescriptor interface {
+	Aliases() map[string][]string
+}
+
 // lookupEnumValue provides a reverse lookup of an enum value
 // given a string value.
 type lookupEnumValue[E ~int] map[string]E
@@ -216,6 +224,19 @@ func (info *EnumInfo[E]) AcceptablePrimes() string {
 	return slashes + strings.Join(elements, "/") + slashes
 }
 
+// Aliases returns the acceptable values for each enumeration in this enum
+// info, keyed by its primary (first) acceptable value. Satisfies the
+// EnumDescriptor interface.
+func (info *EnumInfo[E]) Aliases() map[string][]string {
+	aliases := make(map[string][]string, len(info.acceptables))
+
+	for _, values := range info.acceptables {
+		aliases[values[0]] = slices.Clone(values)
+	}
+
+	return aliases
+}
+
 // NameOf returns the first acceptable name for the enum value specified.
 // Ideally, there would be a way in go reflection to obtain the name of a
 // variable (as opposed to type name), but this isn't possible. Go reflection
@@ -0,0 +1,260 @@
+package assistant
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+)
+
+const (
+	// SuccessExitCode is the exit code when the command completes without error
+	SuccessExitCode = 0
+
+	// UnclassifiedExitCode is the default exit code for errors that do not
+	// declare a category
+	UnclassifiedExitCode = 1
+
+	// ValidationExitCode is the default exit code for option validation errors
+	ValidationExitCode = 2
+
+	// NativeExitCode is the default exit code for internal errors
+	NativeExitCode = 3
+)
+
+// ErrorFormat denotes the form in which errors are rendered by Execute.
+type ErrorFormat string
+
+const (
+	// TextErrorFormat renders errors as translated prose
+	TextErrorFormat ErrorFormat = "text"
+
+	// JSONErrorFormat renders errors as json
+	JSONErrorFormat ErrorFormat = "json"
+
+	// ErrorFormatFlagName is the name of the flag registered by
+	// BindErrorFormat
+	ErrorFormatFlagName = "error-format"
+)
+
+// ExitCodes maps error categories to process exit codes.
+type ExitCodes map[locale.ErrorCategory]int
+
+// ErrorRenderer presents an error returned by command execution to the
+// user.
+type ErrorRenderer interface {
+	Render(writer io.Writer, err error, category locale.ErrorCategory)
+}
+
+// TextErrorRenderer is the default ErrorRenderer, which writes the error
+// message as a single line of text.
+type TextErrorRenderer struct{}
+
+func (TextErrorRenderer) Render(writer io.Writer, err error, _ locale.ErrorCategory) {
+	_, _ = fmt.Fprintf(writer, "Error: %v\n", err)
+}
+
+// ErrorReport is the json form of an error rendered by JSONErrorRenderer.
+// Validation errors are reported as structured failures, other errors
+// as a message.
+type ErrorReport struct {
+	Category locale.ErrorCategory        `json:"category"`
+	Message  string                      `json:"message,omitempty"`
+	Failures []*locale.ValidationFailure `json:"failures,omitempty"`
+}
+
+// JSONErrorRenderer is an ErrorRenderer that writes the error as an
+// ErrorReport in json.
+type JSONErrorRenderer struct{}
+
+func (JSONErrorRenderer) Render(writer io.Writer, err error, category locale.ErrorCategory) {
+	report := ErrorReport{
+		Category: category,
+		Failures: ValidationFailures(err),
+	}
+
+	if len(report.Failures) == 0 {
+		report.Message = err.Error()
+	}
+
+	_ = json.NewEncoder(writer).Encode(report)
+}
+
+// ValidationFailures returns the structured form of all the option
+// validation errors contained within the error, including those that have
+// been aggregated or wrapped.
+func ValidationFailures(err error) []*locale.ValidationFailure {
+	failures := []*locale.ValidationFailure{}
+
+	if err == nil {
+		return failures
+	}
+
+	if structured, ok := err.(locale.StructuredValidationError); ok {
+		return append(failures, structured.Failure())
+	}
+
+	switch wrapper := err.(type) { //nolint:errorlint // the error tree is traversed explicitly
+	case interface{ Unwrap() []error }:
+		for _, inner := range wrapper.Unwrap() {
+			failures = append(failures, ValidationFailures(inner)...)
+		}
+
+	case interface{ Unwrap() error }:
+		failures = append(failures, ValidationFailures(wrapper.Unwrap())...)
+	}
+
+	return failures
+}
+
+func defaultExitCodes() ExitCodes {
+	return ExitCodes{
+		locale.UnclassifiedErrorCategory: UnclassifiedExitCode,
+		locale.ValidationErrorCategory:   ValidationExitCode,
+		locale.NativeErrorCategory:       NativeExitCode,
+	}
+}
+
+// MapExitCode overrides the exit code returned by Execute for errors of the
+// category specified.
+func (container *CobraContainer) MapExitCode(category locale.ErrorCategory, code int) {
+	container.exitCodes[category] = code
+}
+
+// UseErrorRenderer replaces the renderer used by Execute to present errors.
+func (container *CobraContainer) UseErrorRenderer(renderer ErrorRenderer) {
+	container.renderer = renderer
+}
+
+// MapErrorRenderer registers the renderer used by Execute when the error
+// format specified on the command line (see BindErrorFormat) is format.
+func (container *CobraContainer) MapErrorRenderer(format ErrorFormat, renderer ErrorRenderer) {
+	container.renderers[format] = renderer
+}
+
+// BindErrorFormat registers the persistent flag "--error-format" on the root
+// command, allowing the user to select the form in which errors are rendered
+// by Execute, eg "--error-format json".
+func (container *CobraContainer) BindErrorFormat() {
+	container.root.PersistentFlags().StringVar(
+		&container.errorFormat, ErrorFormatFlagName, string(TextErrorFormat),
+		fmt.Sprintf("format of error output (%v|%v)", TextErrorFormat, JSONErrorFormat),
+	)
+}
+
+// ExitCode maps an error to an exit code. An error that implements
+// locale.ExitCoder dictates its own exit code, otherwise the code is
+// mapped from the error's category.
+func (container *CobraContainer) ExitCode(err error) int {
+	if err == nil {
+		return SuccessExitCode
+	}
+
+	var coder locale.ExitCoder
+	if errors.As(err, &coder) {
+		return coder.ExitCode()
+	}
+
+	if code, found := container.exitCodes[locale.CategoryOf(err)]; found {
+		return code
+	}
+
+	return container.exitCodes[locale.UnclassifiedErrorCategory]
+}
+
+// ExecuteE checks the command tree for short flag collisions (see
+// CheckShortFlags), applies the registered middleware to the command tree
+// and then executes the root command, returning the resulting error. The
+// errors that occur as the command line is parsed, eg an unknown flag or an
+// invalid option value, are reported as validation errors, from which the
+// values of sensitive flags (see FlagInfo.Sensitive) are redacted.
+func (container *CobraContainer) ExecuteE() error {
+	if err := container.CheckShortFlags(); err != nil {
+		return err
+	}
+
+	container.classifyFlagErrors()
+
+	container.ApplyMiddleware()
+
+	return container.root.Execute()
+}
+
+// Execute applies the registered middleware to the command tree, executes
+// the root command and returns the exit code mapped from the error, if
+// any. The error is presented by the error renderer, rather than by cobra,
+// which is also prevented from displaying usage, so that the rendered error
+// is not mixed with it, eg when the error format is json. The client would
+// typically pass the result to os.Exit.
+func (container *CobraContainer) Execute() int {
+	container.root.SilenceErrors = true
+	container.root.SilenceUsage = true
+
+	err := container.ExecuteE()
+	if err == nil {
+		return SuccessExitCode
+	}
+
+	renderer := container.renderer
+	if r, found := container.renderers[ErrorFormat(container.errorFormat)]; found {
+		renderer = r
+	}
+
+	renderer.Render(container.root.ErrOrStderr(), err, locale.CategoryOf(err))
+
+	return container.ExitCode(err)
+}
+
+// flagNameRx matches the name of the flag in the errors reported by pflag,
+// in either its long form, eg "--offset", or short form, eg "'o' in -o".
+var flagNameRx = regexp.MustCompile(`--([^\s"=]+)|'(.)' in -`)
+
+// classifyFlagErrors installs a flag error function on the root command,
+// that converts the errors that occur as the command line is parsed into
+// validation errors, so that they are distinguished from internal errors,
+// before delegating to the existing function.
+func (container *CobraContainer) classifyFlagErrors() {
+	if container.classifying {
+		return
+	}
+
+	container.classifying = true
+	inner := container.root.FlagErrorFunc()
+
+	container.root.SetFlagErrorFunc(func(command *cobra.Command, err error) error {
+		value, err := redactFlagError(command, err)
+
+		return inner(command, locale.NewFlagParseValidationError(
+			flagName(command, err), value, err.Error(),
+		))
+	})
+}
+
+// flagName returns the long name of the flag reported by the error, or
+// empty if the error does not identify one.
+func flagName(command *cobra.Command, err error) string {
+	if match := invalidArgumentRx.FindStringSubmatch(err.Error()); match != nil {
+		return match[2]
+	}
+
+	match := flagNameRx.FindStringSubmatch(err.Error())
+
+	switch {
+	case match == nil:
+		return ""
+
+	case match[1] != "":
+		return match[1]
+	}
+
+	if flag := command.Flags().ShorthandLookup(match[2]); flag != nil {
+		return flag.Name
+	}
+
+	return match[2]
+}
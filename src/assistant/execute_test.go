@@ -0,0 +1,226 @@
+package assistant_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant"
+	"github.com/snivilised/cobrass/src/assistant/locale"
+)
+
+type fakeRenderer struct {
+	err      error
+	category locale.ErrorCategory
+}
+
+func (r *fakeRenderer) Render(_ io.Writer, err error, category locale.ErrorCategory) {
+	r.err = err
+	r.category = category
+}
+
+type fakeExitCoderError struct{}
+
+func (fakeExitCoderError) Error() string {
+	return "fake exit coder error"
+}
+
+func (fakeExitCoderError) ExitCode() int {
+	return 42
+}
+
+type executeTE struct {
+	given    string
+	should   string
+	err      error
+	codes    assistant.ExitCodes
+	category locale.ErrorCategory
+	expected int
+}
+
+var _ = Describe("Execute", func() {
+	var (
+		container *assistant.CobraContainer
+		renderer  *fakeRenderer
+	)
+
+	BeforeEach(func() {
+		renderer = &fakeRenderer{}
+	})
+
+	DescribeTable("exit code mapping",
+		func(entry *executeTE) {
+			container = assistant.NewCobraContainer(&cobra.Command{
+				Use: "root",
+				RunE: func(_ *cobra.Command, _ []string) error {
+					return entry.err
+				},
+			})
+			container.Root().SetArgs([]string{})
+			container.UseErrorRenderer(renderer)
+
+			for category, code := range entry.codes {
+				container.MapExitCode(category, code)
+			}
+
+			Expect(container.Execute()).To(Equal(entry.expected))
+
+			if entry.err != nil {
+				Expect(renderer.err).To(MatchError(entry.err))
+				Expect(renderer.category).To(Equal(entry.category))
+			} else {
+				Expect(renderer.err).To(BeNil())
+			}
+		},
+		func(entry *executeTE) string {
+			return "🧪 --> given: " + entry.given + ", should: " + entry.should
+		},
+
+		Entry(nil, &executeTE{
+			given:    "no error",
+			should:   "return success exit code",
+			expected: assistant.SuccessExitCode,
+		}),
+
+		Entry(nil, &executeTE{
+			given:    "validation error",
+			should:   "return validation exit code",
+			err:      locale.NewWithinOptValidationError("offset", 20, 0, 10),
+			category: locale.ValidationErrorCategory,
+			expected: assistant.ValidationExitCode,
+		}),
+
+		Entry(nil, &executeTE{
+			given:    "native error",
+			should:   "return native exit code",
+			err:      locale.NewParamSetNotFoundNativeError("widget-ps"),
+			category: locale.NativeErrorCategory,
+			expected: assistant.NativeExitCode,
+		}),
+
+		Entry(nil, &executeTE{
+			given:    "unclassified error",
+			should:   "return unclassified exit code",
+			err:      errors.New("fake error"),
+			category: locale.UnclassifiedErrorCategory,
+			expected: assistant.UnclassifiedExitCode,
+		}),
+
+		Entry(nil, &executeTE{
+			given:    "remapped validation error",
+			should:   "return configured exit code",
+			err:      locale.NewMatchOptValidationError("pattern", "foo", "^bar$"),
+			codes:    assistant.ExitCodes{locale.ValidationErrorCategory: 64},
+			category: locale.ValidationErrorCategory,
+			expected: 64,
+		}),
+
+		Entry(nil, &executeTE{
+			given:    "error that is an exit coder",
+			should:   "return exit code of the error",
+			err:      fakeExitCoderError{},
+			category: locale.UnclassifiedErrorCategory,
+			expected: 42,
+		}),
+	)
+
+	DescribeTable("flag parse errors",
+		func(args []string, flag string, value any) {
+			rootCommand := &cobra.Command{
+				Use: "poke",
+				RunE: func(_ *cobra.Command, _ []string) error {
+					return nil
+				},
+			}
+			container = assistant.NewCobraContainer(rootCommand)
+			container.BindErrorFormat()
+
+			paramSet := assistant.NewParamSet[WidgetParameterSet](rootCommand)
+			paramSet.BindInt(
+				assistant.NewFlagInfo("offset is the offset", "o", 0),
+				&paramSet.Native.Offset,
+			)
+
+			buffer := &bytes.Buffer{}
+			rootCommand.SetOut(buffer)
+			rootCommand.SetErr(buffer)
+			rootCommand.SetArgs(append([]string{"--error-format", "json"}, args...))
+
+			Expect(container.Execute()).To(Equal(assistant.ValidationExitCode))
+
+			report := assistant.ErrorReport{}
+			Expect(json.Unmarshal(buffer.Bytes(), &report)).To(Succeed())
+			Expect(report.Category).To(Equal(locale.ValidationErrorCategory))
+			Expect(report.Failures).To(HaveLen(1))
+			Expect(report.Failures[0].Flag).To(Equal(flag))
+			if value == nil {
+				Expect(report.Failures[0].Value).To(BeNil())
+			} else {
+				Expect(report.Failures[0].Value).To(Equal(value))
+			}
+			Expect(report.Failures[0].Constraint).To(Equal(locale.ConstraintFlagParse))
+		},
+		func(args []string, flag string, _ any) string {
+			return "🧪 --> given: " + strings.Join(args, " ") + ", should: report validation failure of " + flag
+		},
+
+		Entry(nil, []string{"--offset", "abc"}, "offset", "abc"),
+		Entry(nil, []string{"-o", "abc"}, "offset", "abc"),
+		Entry(nil, []string{"--bogus"}, "bogus", nil),
+		Entry(nil, []string{"--offset"}, "offset", nil),
+	)
+
+	Context("given: json error format", func() {
+		It("🧪 should: render aggregated validation failures as json", func() {
+			rootCommand := &cobra.Command{Use: "poke"}
+			container = assistant.NewCobraContainer(rootCommand)
+			container.BindErrorFormat()
+
+			paramSet := assistant.NewParamSet[WidgetParameterSet](rootCommand)
+			paramSet.BindValidatedIntWithin(
+				assistant.NewFlagInfo("offset is the offset", "o", 0),
+				&paramSet.Native.Offset, 0, 10,
+			)
+			paramSet.BindValidatedStringIsMatch(
+				assistant.NewFlagInfo("pattern is the pattern", "p", "foo"),
+				&paramSet.Native.Pattern, "^foo",
+			)
+			rootCommand.RunE = func(_ *cobra.Command, _ []string) error {
+				return paramSet.ValidateAll()
+			}
+
+			// cobra writes usage to the same stream as errors, unless the
+			// output stream has been set
+			//
+			buffer := &bytes.Buffer{}
+			rootCommand.SetOut(buffer)
+			rootCommand.SetErr(buffer)
+			rootCommand.SetArgs([]string{
+				"--offset", "20", "--pattern", "bar", "--error-format", "json",
+			})
+
+			Expect(container.Execute()).To(Equal(assistant.ValidationExitCode))
+
+			report := assistant.ErrorReport{}
+			Expect(json.Unmarshal(buffer.Bytes(), &report)).To(Succeed())
+			Expect(report.Category).To(Equal(locale.ValidationErrorCategory))
+			Expect(report.Message).To(BeEmpty())
+			Expect(report.Failures).To(HaveLen(2))
+
+			Expect(report.Failures[0].Flag).To(Equal("offset"))
+			Expect(report.Failures[0].Constraint).To(Equal(string(assistant.ConstraintWithin)))
+			Expect(report.Failures[0].Params).To(HaveKeyWithValue("high", float64(10)))
+
+			Expect(report.Failures[1].Flag).To(Equal("pattern"))
+			Expect(report.Failures[1].Value).To(Equal("bar"))
+			Expect(report.Failures[1].Constraint).To(Equal(string(assistant.ConstraintMatch)))
+			Expect(report.Failures[1].Params).To(HaveKeyWithValue("pattern", "^foo"))
+		})
+	})
+})
@@ -12,7 +12,9 @@ import (
 // provides, mapping long form flag names to their short form. The client can
 // choose to compose a command line consisting of all available flags or just
 // the ones changed by the user (ie, they are explicitly specified on the
-// command line as opposed to be defaulted).
+// command line as opposed to be defaulted). The values of sensitive flags
+// are included as is, since the command line is intended for invocation; use
+// clif.Redact with SensitiveFlagNames to compose a command line for display.
 func GetThirdPartyCL(
 	flagSet *pflag.FlagSet,
 	knownBy cobrass.KnownByCollection,
@@ -0,0 +1,192 @@
+package assistant
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+)
+
+// LazyAnnotation is the annotation set on the placeholder of a lazy command
+// that has not yet been materialised.
+const LazyAnnotation = "cobrass_annotation_lazy"
+
+// CommandMetadata is the lightweight description of a lazy command, which
+// is available without having to invoke the command's factory.
+type CommandMetadata struct {
+	// Name of the command, must match the name of the command created by
+	// the factory.
+	//
+	Name string
+
+	// Short description shown in the help of the parent command
+	//
+	Short string
+
+	// Aliases of the command
+	//
+	Aliases []string
+}
+
+// CommandFactory creates the command on demand. The factory should also
+// bind and register the command's parameter sets with the container.
+type CommandFactory func(container *CobraContainer) *cobra.Command
+
+type lazyCommand struct {
+	parent   string
+	metadata *CommandMetadata
+	factory  CommandFactory
+}
+
+type lazyCommandsCollection map[string]*lazyCommand
+
+// MustRegisterLazyCommand registers a command whose construction is deferred
+// until it is resolved, ie when it is executed, its help is requested or it
+// is the subject of shell completion. Until then, the command is represented
+// in the command tree by a placeholder created from its metadata, so IsPresent,
+// help of the parent and Manifest work without invoking the factory. Note,
+// the manifest of a command that has not been materialised only describes
+// its metadata. Command materialises the lazy command.
+//
+// - parent: the name of the parent command, which must not itself be lazy.
+//
+// - metadata: the lightweight description of the command.
+//
+// - factory: creates the command.
+//
+// panics if the there is no command currently registered with the name of
+// parent or a command has already been registered with the same name.
+func (container *CobraContainer) MustRegisterLazyCommand(parent string,
+	metadata *CommandMetadata, factory CommandFactory,
+) {
+	container.MustRegisterCommand(parent, container.placeholder(metadata))
+
+	container.lazy[metadata.Name] = &lazyCommand{
+		parent:   parent,
+		metadata: metadata,
+		factory:  factory,
+	}
+}
+
+// MustRegisterRootedLazyCommand invokes MustRegisterLazyCommand with the root
+// command as the parent.
+func (container *CobraContainer) MustRegisterRootedLazyCommand(metadata *CommandMetadata,
+	factory CommandFactory,
+) {
+	container.MustRegisterLazyCommand(container.root.Name(), metadata, factory)
+}
+
+// IsMaterialised determines whether the command registered under the name
+// specified has been created. Commands not registered lazily are always
+// materialised.
+func (container *CobraContainer) IsMaterialised(name string) bool {
+	_, pending := container.lazy[name]
+
+	return container.IsPresent(name) && !pending
+}
+
+func isLazy(command *cobra.Command) bool {
+	_, found := command.Annotations[LazyAnnotation]
+
+	return found
+}
+
+// materialise invokes the factory of the lazy command and replaces the
+// placeholder with the command created.
+func (container *CobraContainer) materialise(name string) *cobra.Command {
+	lazy, found := container.lazy[name]
+	if !found {
+		return container.commands[name]
+	}
+
+	delete(container.lazy, name)
+
+	command := lazy.factory(container)
+	if command.Name() != name {
+		panic(locale.NewLazyCommandNameMismatchNativeError(name, command.Name()))
+	}
+
+	parent := container.Command(lazy.parent)
+	parent.RemoveCommand(container.commands[name])
+	parent.AddCommand(command)
+	container.commands[name] = command
+
+	if container.applied {
+		container.ApplyMiddleware()
+	}
+
+	return command
+}
+
+// placeholder creates the command that stands in for the lazy command. Flag
+// parsing is disabled, so that when invoked, the placeholder can materialise
+// the real command and dispatch the original arguments to it.
+func (container *CobraContainer) placeholder(metadata *CommandMetadata) *cobra.Command {
+	name := metadata.Name
+	command := &cobra.Command{
+		Use:                name,
+		Short:              metadata.Short,
+		Aliases:            metadata.Aliases,
+		DisableFlagParsing: true,
+		SilenceErrors:      true,
+		SilenceUsage:       true,
+		Annotations: map[string]string{
+			LazyAnnotation: name,
+		},
+		// suppress the persistent pre run of ancestors, which are invoked
+		// when the real command is dispatched to.
+		//
+		PersistentPreRun: func(_ *cobra.Command, _ []string) {},
+		RunE: func(command *cobra.Command, args []string) error {
+			// the command path contains the names of the commands from the root
+			// which must be resolved again, this time to the real command. This
+			// must be acquired before materialising, because that detaches the
+			// placeholder from the command tree.
+			//
+			path := strings.Fields(command.CommandPath())[1:]
+			root := command.Root()
+
+			container.materialise(name)
+			root.SetArgs(append(path, args...))
+
+			return root.ExecuteContext(command.Context())
+		},
+		ValidArgsFunction: func(command *cobra.Command, args []string,
+			toComplete string,
+		) ([]string, cobra.ShellCompDirective) {
+			// cobra writes the completions to the output of the placeholder,
+			// which is inherited from the root, so this has to be fixed before
+			// the placeholder is detached from the command tree.
+			//
+			command.SetOut(command.OutOrStdout())
+			command.SetErr(command.ErrOrStderr())
+
+			materialised := container.materialise(name)
+
+			if strings.HasPrefix(toComplete, "-") {
+				flags := []string{}
+				materialised.InitDefaultHelpFlag()
+				materialised.Flags().VisitAll(func(flag *pflag.Flag) {
+					flags = append(flags, "--"+flag.Name)
+				})
+
+				return flags, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			if materialised.ValidArgsFunction != nil {
+				return materialised.ValidArgsFunction(materialised, args, toComplete)
+			}
+
+			return materialised.ValidArgs, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+
+	command.SetHelpFunc(func(_ *cobra.Command, args []string) {
+		materialised := container.materialise(name)
+		materialised.HelpFunc()(materialised, args)
+	})
+
+	return command
+}
@@ -0,0 +1,132 @@
+package assistant_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant"
+)
+
+var _ = Describe("LazyCommand", func() {
+	var (
+		container *assistant.CobraContainer
+		created   int
+		preRuns   int
+		received  []string
+		buffer    *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		created = 0
+		preRuns = 0
+		received = nil
+		buffer = &bytes.Buffer{}
+
+		container = assistant.NewCobraContainer(&cobra.Command{
+			Use: "poke",
+			PersistentPreRun: func(_ *cobra.Command, _ []string) {
+				preRuns++
+			},
+		})
+		container.Root().SetOut(buffer)
+
+		container.MustRegisterRootedLazyCommand(&assistant.CommandMetadata{
+			Name:    "widget",
+			Short:   "widget command",
+			Aliases: []string{"w"},
+		}, func(c *assistant.CobraContainer) *cobra.Command {
+			created++
+
+			widgetCommand := &cobra.Command{
+				Use:   "widget",
+				Short: "widget command",
+				RunE: func(_ *cobra.Command, args []string) error {
+					received = args
+
+					return nil
+				},
+			}
+
+			paramSet := assistant.NewParamSet[WidgetParameterSet](widgetCommand)
+			paramSet.BindInt(
+				assistant.NewFlagInfo("offset is the offset", "o", 0),
+				&paramSet.Native.Offset,
+			)
+			c.MustRegisterParamSet("widget-ps", paramSet)
+
+			return widgetCommand
+		})
+	})
+
+	Context("given: lazy command not resolved", func() {
+		It("🧪 should: be present without being created", func() {
+			Expect(container.IsPresent("widget")).To(BeTrue())
+			Expect(container.IsMaterialised("widget")).To(BeFalse())
+
+			manifest := container.Manifest()
+			Expect(manifest.Root.Commands).To(HaveLen(1))
+			Expect(manifest.Root.Commands[0].Short).To(Equal("widget command"))
+			Expect(created).To(Equal(0))
+		})
+	})
+
+	Context("given: lazy command executed", func() {
+		It("🧪 should: create command and dispatch arguments", func() {
+			container.Root().SetArgs([]string{"w", "--offset", "3", "foo"})
+
+			Expect(container.ExecuteE()).To(Succeed())
+			Expect(created).To(Equal(1))
+			Expect(preRuns).To(Equal(1))
+			Expect(received).To(HaveExactElements("foo"))
+			Expect(container.IsMaterialised("widget")).To(BeTrue())
+
+			paramSet, _ := container.MustGetParamSet("widget-ps").(*assistant.ParamSet[WidgetParameterSet])
+			Expect(paramSet.Native.Offset).To(Equal(3))
+		})
+	})
+
+	Context("given: help requested for lazy command", func() {
+		It("🧪 should: create command and show its help", func() {
+			container.Root().SetArgs([]string{"help", "widget"})
+
+			Expect(container.ExecuteE()).To(Succeed())
+			Expect(created).To(Equal(1))
+			Expect(buffer.String()).To(ContainSubstring("--offset"))
+		})
+	})
+
+	Context("given: completion requested for lazy command", func() {
+		It("🧪 should: create command and complete its flags", func() {
+			container.Root().SetArgs([]string{cobra.ShellCompRequestCmd, "widget", "--"})
+
+			Expect(container.ExecuteE()).To(Succeed())
+			Expect(created).To(Equal(1))
+			Expect(buffer.String()).To(ContainSubstring("--offset"))
+		})
+	})
+
+	Context("given: Command invoked for lazy command", func() {
+		It("🧪 should: create the command once", func() {
+			Expect(container.Command("widget").Name()).To(Equal("widget"))
+			Expect(container.Command("widget").Name()).To(Equal("widget"))
+			Expect(created).To(Equal(1))
+		})
+	})
+
+	Context("given: factory creates command with different name", func() {
+		It("🧪 should: panic", func() {
+			container.MustRegisterRootedLazyCommand(&assistant.CommandMetadata{
+				Name: "gadget",
+			}, func(_ *assistant.CobraContainer) *cobra.Command {
+				return &cobra.Command{Use: "gizmo"}
+			})
+
+			Expect(func() {
+				container.Command("gadget")
+			}).To(Panic())
+		})
+	})
+})
@@ -0,0 +1,278 @@
+package locale
+
+import (
+	"errors"
+	"reflect"
+)
+
+// ErrorCategory classifies an error, so that it can be mapped to an
+// exit code.
+type ErrorCategory string
+
+const (
+	// UnclassifiedErrorCategory the error does not declare a category,
+	// eg those returned by the client's run function
+	UnclassifiedErrorCategory ErrorCategory = "unclassified"
+
+	// ValidationErrorCategory the user provided an invalid option value
+	ValidationErrorCategory ErrorCategory = "validation"
+
+	// NativeErrorCategory an internal error, arising from a programming
+	// error, not the user's input
+	NativeErrorCategory ErrorCategory = "native"
+)
+
+// CategorisedError is implemented by errors that declare their
+// category.
+type CategorisedError interface {
+	error
+	Category() ErrorCategory
+}
+
+// ExitCoder is implemented by errors that dictate the exit code of the
+// process, overriding the code mapped from their category.
+type ExitCoder interface {
+	error
+	ExitCode() int
+}
+
+// CategoryOf returns the category declared by the error, or
+// UnclassifiedErrorCategory if it does not declare one.
+func CategoryOf(err error) ErrorCategory {
+	var categorised CategorisedError
+
+	if errors.As(err, &categorised) {
+		return categorised.Category()
+	}
+
+	return UnclassifiedErrorCategory
+}
+
+// The constraints of the predefined validation helpers, as reported by
+// ValidationFailure. These are the values of assistant.ConstraintKind.
+const (
+	ConstraintWithin      = "within"
+	ConstraintNotWithin   = "not-within"
+	ConstraintContains    = "contains"
+	ConstraintNotContains = "not-contains"
+	ConstraintMatch       = "match"
+	ConstraintNotMatch    = "not-match"
+	ConstraintGreaterThan = "greater-than"
+	ConstraintAtLeast     = "at-least"
+	ConstraintLessThan    = "less-than"
+	ConstraintAtMost      = "at-most"
+)
+
+// The constraints of the validation errors that are not raised by the
+// predefined validation helpers, as reported by ValidationFailure.
+const (
+	ConstraintFlagParse             = "flag-parse"
+	ConstraintGlobFilter            = "glob-filter"
+	ConstraintAmbiguousParamSet     = "ambiguous-param-set"
+	ConstraintNoMatchingParamSet    = "no-matching-param-set"
+	ConstraintRequired              = "required"
+	ConstraintRequiredIf            = "required-if"
+	ConstraintTime                  = "time"
+	ConstraintURLScheme             = "url-scheme"
+	ConstraintQuantity              = "quantity"
+	ConstraintRange                 = "range"
+	ConstraintMapEntry              = "map-entry"
+	ConstraintThirdPartyFlag        = "third-party-flag"
+	ConstraintThirdPartyArity       = "third-party-arity"
+	ConstraintThirdPartyType        = "third-party-type"
+	ConstraintThirdPartyAcceptables = "third-party-acceptables"
+	ConstraintThirdPartyRepeat      = "third-party-repeat"
+)
+
+// ValidationFailure is the structured form of an option validation error,
+// suitable for machine consumption, eg rendering as json.
+type ValidationFailure struct {
+	// Flag is the name of the flag whose value failed validation
+	//
+	Flag string `json:"flag,omitempty"`
+
+	// Value is the value that failed validation
+	//
+	Value any `json:"value,omitempty"`
+
+	// Constraint is the kind of constraint violated, eg "within". These
+	// correspond to assistant.ConstraintKind.
+	//
+	Constraint string `json:"constraint"`
+
+	// Params are the parameters of the constraint, eg "low" and "high"
+	// for "within".
+	//
+	Params map[string]any `json:"params,omitempty"`
+}
+
+// StructuredValidationError is implemented by all option validation errors.
+type StructuredValidationError interface {
+	CategorisedError
+	Failure() *ValidationFailure
+}
+
+// validationError is embedded into user facing option validation errors
+type validationError struct {
+	failure *ValidationFailure
+}
+
+func (validationError) Category() ErrorCategory {
+	return ValidationErrorCategory
+}
+
+// Failure returns the structured form of the validation error.
+func (e validationError) Failure() *ValidationFailure {
+	return e.failure
+}
+
+func failure(constraint, flag string, value any, params map[string]any) validationError {
+	return validationError{
+		failure: &ValidationFailure{
+			Flag:       flag,
+			Value:      value,
+			Constraint: constraint,
+			Params:     params,
+		},
+	}
+}
+
+// attribute sets the flag of the failure, unless it already identifies one
+func (e *validationError) attribute(flag string) {
+	if e.failure != nil && e.failure.Flag == "" {
+		failure := *e.failure
+		failure.Flag = flag
+		e.failure = &failure
+	}
+}
+
+// redact replaces the offending value of the failure
+func (e *validationError) redact() {
+	if e.failure != nil {
+		failure := *e.failure
+		failure.Value = RedactedValue
+		e.failure = &failure
+	}
+}
+
+// RedactedValue is displayed in place of the value of a sensitive flag.
+const RedactedValue = "******"
+
+// Redact returns a copy of the validation error in which the offending
+// value is replaced by RedactedValue, both in the message and in the
+// structured failure. Errors that do not carry a value are returned as is,
+// which includes errors not created by cobrass, eg those returned by client
+// validator functions, whose value can only be redacted by matching it in
+// the message.
+func Redact(err error) error {
+	clone, ok := cloneError(err)
+	if !ok {
+		return err
+	}
+
+	data := clone.Elem().FieldByName("LocalisableError")
+	if !data.IsValid() {
+		return err
+	}
+
+	if data = data.FieldByName("Data"); !data.IsNil() {
+		redacted := reflect.New(data.Elem().Type()).Elem()
+		redacted.Set(data.Elem())
+
+		value := redacted.FieldByName("Value")
+		replacement := reflect.ValueOf(RedactedValue)
+
+		if value.IsValid() && value.CanSet() && replacement.Type().ConvertibleTo(value.Type()) {
+			value.Set(replacement.Convert(value.Type()))
+		}
+
+		data.Set(redacted)
+	}
+
+	if failure, ok := clone.Interface().(interface{ redact() }); ok {
+		failure.redact()
+	}
+
+	return clone.Interface().(error)
+}
+
+// Attribute returns a copy of the validation error in which the structured
+// failure identifies the flag specified, unless it already identifies one.
+// This enables the errors raised by functions that are unaware of the flag
+// whose value they parse, eg ValueCodec.Parse, to be attributed to it.
+// Errors not created by cobrass are returned as is.
+func Attribute(err error, flag string) error {
+	clone, ok := cloneError(err)
+	if !ok {
+		return err
+	}
+
+	failure, ok := clone.Interface().(interface{ attribute(flag string) })
+	if !ok {
+		return err
+	}
+
+	failure.attribute(flag)
+
+	return clone.Interface().(error)
+}
+
+// cloneError creates a shallow copy of the error, if it is a pointer to a
+// struct.
+func cloneError(err error) (reflect.Value, bool) {
+	original := reflect.ValueOf(err)
+
+	if original.Kind() != reflect.Pointer || original.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	clone := reflect.New(original.Elem().Type())
+	clone.Elem().Set(original.Elem())
+
+	return clone, true
+}
+
+// nativeError wraps the non user facing internal errors
+type nativeError struct {
+	error
+}
+
+func (e nativeError) Category() ErrorCategory {
+	return NativeErrorCategory
+}
+
+func (e nativeError) Unwrap() error {
+	return e.error
+}
+
+func newNativeError(err error) error {
+	return &nativeError{error: err}
+}
+
+// AggregateValidationError collects all the option validation errors
+// that occurred for a parameter set.
+type AggregateValidationError struct {
+	Errors []error
+}
+
+func (e *AggregateValidationError) Error() string {
+	return errors.Join(e.Errors...).Error()
+}
+
+func (e *AggregateValidationError) Unwrap() []error {
+	return e.Errors
+}
+
+func (e *AggregateValidationError) Category() ErrorCategory {
+	return ValidationErrorCategory
+}
+
+// NewAggregateValidationError creates an aggregate error from the errors
+// specified, nil if there are none.
+func NewAggregateValidationError(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &AggregateValidationError{Errors: errs}
+}
@@ -0,0 +1,203 @@
+package locale
+
+import (
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/snivilised/li18ngo"
+)
+
+// These are user facing errors messages that occur during the
+// execution of a command, as opposed to those that occur as a
+// result of validating the user provided options.
+//
+
+// ❌ CommandPanicTemplData
+
+// CommandPanicTemplData
+type CommandPanicTemplData struct {
+	CobrassTemplData
+	Command string
+	Reason  any
+}
+
+func (td CommandPanicTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "command-panic.cobrass",
+		Description: "Command execution was aborted due to an unexpected panic.",
+		Other:       "({{.Command}}): command failed unexpectedly: '{{.Reason}}'",
+	}
+}
+
+type CommandPanicBehaviourQuery interface {
+	error
+	IsCommandPanic() bool
+}
+
+type CommandPanicError struct {
+	li18ngo.LocalisableError
+}
+
+// Category a panic is the result of a programming error, rather
+// than the user's input, so is classified as native.
+func (e CommandPanicError) Category() ErrorCategory {
+	return NativeErrorCategory
+}
+
+func (e CommandPanicError) IsCommandPanic() bool {
+	return true
+}
+
+func NewCommandPanicError(command string, reason any) CommandPanicBehaviourQuery {
+	return &CommandPanicError{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: CommandPanicTemplData{
+				Command: command,
+				Reason:  reason,
+			},
+		},
+	}
+}
+
+// ❌ PluginExitTemplData
+
+// PluginExitTemplData
+type PluginExitTemplData struct {
+	CobrassTemplData
+	Plugin string
+	Code   int
+}
+
+func (td PluginExitTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "plugin-exit.cobrass",
+		Description: "External plugin command exited with a non zero exit code.",
+		Other:       "plugin '{{.Plugin}}' exited with code: '{{.Code}}'",
+	}
+}
+
+type PluginExitBehaviourQuery interface {
+	ExitCoder
+	IsPluginExit() bool
+}
+
+type PluginExitError struct {
+	li18ngo.LocalisableError
+	code int
+}
+
+func (e PluginExitError) IsPluginExit() bool {
+	return true
+}
+
+// ExitCode the exit code of the plugin is propagated as the exit
+// code of the host process.
+func (e PluginExitError) ExitCode() int {
+	return e.code
+}
+
+func NewPluginExitError(plugin string, code int) PluginExitBehaviourQuery {
+	return &PluginExitError{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: PluginExitTemplData{
+				Plugin: plugin,
+				Code:   code,
+			},
+		},
+		code: code,
+	}
+}
+
+// ❌ ResponseFileTemplData
+
+// ResponseFileTemplData
+type ResponseFileTemplData struct {
+	CobrassTemplData
+	Path   string
+	Reason error
+}
+
+func (td ResponseFileTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "response-file.cobrass",
+		Description: "Response file containing command line arguments could not be read or parsed.",
+		Other:       "failed to expand response file '{{.Path}}': '{{.Reason}}'",
+	}
+}
+
+type ResponseFileBehaviourQuery interface {
+	error
+	IsResponseFile() bool
+}
+
+type ResponseFileError struct {
+	li18ngo.LocalisableError
+	reason error
+}
+
+func (e ResponseFileError) IsResponseFile() bool {
+	return true
+}
+
+func (e ResponseFileError) Unwrap() error {
+	return e.reason
+}
+
+func NewResponseFileError(path string, reason error) ResponseFileBehaviourQuery {
+	return &ResponseFileError{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: ResponseFileTemplData{
+				Path:   path,
+				Reason: reason,
+			},
+		},
+		reason: reason,
+	}
+}
+
+// ❌ ValueFileTemplData
+
+// ValueFileTemplData
+type ValueFileTemplData struct {
+	CobrassTemplData
+	Flag   string
+	Path   string
+	Reason error
+}
+
+func (td ValueFileTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "value-file.cobrass",
+		Description: "File containing the value of a flag could not be read.",
+		Other:       "failed to read value of flag '{{.Flag}}' from file '{{.Path}}': '{{.Reason}}'",
+	}
+}
+
+type ValueFileBehaviourQuery interface {
+	error
+	IsValueFile() bool
+}
+
+type ValueFileError struct {
+	li18ngo.LocalisableError
+	reason error
+}
+
+func (e ValueFileError) IsValueFile() bool {
+	return true
+}
+
+func (e ValueFileError) Unwrap() error {
+	return e.reason
+}
+
+func NewValueFileError(flag, path string, reason error) ValueFileBehaviourQuery {
+	return &ValueFileError{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: ValueFileTemplData{
+				Flag:   flag,
+				Path:   path,
+				Reason: reason,
+			},
+		},
+		reason: reason,
+	}
+}
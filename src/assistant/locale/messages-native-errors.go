@@ -15,81 +15,123 @@ import (
 
 // NewEnumValueValueAlreadyExistsNativeError enum already exists, invalid enum info specified
 func NewEnumValueValueAlreadyExistsNativeError(value string, number int) error {
-	return fmt.Errorf(
+	return newNativeError(fmt.Errorf(
 		"'%v (%v)' already exists, invalid enum info specified", value, number,
-	)
+	))
 }
 
 // ❌ NewIsNotValidEnumValueNativeError
 
 // NewIsNotValidEnumValueNativeError, is not a valid enum value
 func NewIsNotValidEnumValueNativeError(value string) error {
-	return fmt.Errorf(
+	return newNativeError(fmt.Errorf(
 		"'%v' is not a valid enum value", value,
-	)
+	))
 }
 
 // ❌ failed to add validator
 
 // failed to add validator for flag, because it already exists.
 func NewFailedToAddValidatorAlreadyExistsNativeError(flag string) error {
-	return fmt.Errorf(
+	return newNativeError(fmt.Errorf(
 		"failed to add validator for flag: '%v', because it already exists", flag,
-	)
+	))
 }
 
 // ❌ NewCommandAlreadyRegisteredNativeError
 
 // NewCommandAlreadyRegisteredNativeError, command already registered
 func NewCommandAlreadyRegisteredNativeError(name string) error {
-	return fmt.Errorf(
+	return newNativeError(fmt.Errorf(
 		"cobra container: command '%v' already registered", name,
-	)
+	))
 }
 
 // ❌ NewParentCommandNotRegisteredNativeError
 
 // NewParentCommandNotRegisteredNativeError, parent command not registered
 func NewParentCommandNotRegisteredNativeError(parent string) error {
-	return fmt.Errorf(
+	return newNativeError(fmt.Errorf(
 		"cobra container: parent command '%v' not registered", parent,
-	)
+	))
 }
 
 // ❌ NewParamSetAlreadyRegisteredNativeError
 
 // NewParamSetAlreadyRegisteredNativeError, param set already registered.
 func NewParamSetAlreadyRegisteredNativeError(name string) error {
-	return fmt.Errorf(
+	return newNativeError(fmt.Errorf(
 		"parameter set '%v' already registered", name,
-	)
+	))
 }
 
 // ❌ NewParamSetObjectMustBeStructNativeError
 
 // NewParamSetObjectMustBeStructNativeError, param set must be struct.
 func NewParamSetObjectMustBeStructNativeError(name, typ string) error {
-	return fmt.Errorf(
+	return newNativeError(fmt.Errorf(
 		"the native param set object ('%v') must be a struct, actual type: '%v'",
 		name, typ,
-	)
+	))
 }
 
 // ❌ NewParamSetObjectMustBePointerNativeError
 
 // NewParamSetObjectMustBePointerNativeError, param set must be pointer.
 func NewParamSetObjectMustBePointerNativeError(name, typ string) error {
-	return fmt.Errorf(
+	return newNativeError(fmt.Errorf(
 		"the native param set object ('%v') must be a pointer, actual type: '%v'",
 		name, typ,
-	)
+	))
 }
 
 // ❌ NewParamSetNotFoundNativeError
 
 // NewParamSetNotFoundNativeError, param set not found.
 func NewParamSetNotFoundNativeError(name string) error {
-	return fmt.Errorf(
+	return newNativeError(fmt.Errorf(
 		"parameter set '%v' not found", name,
-	)
+	))
+}
+
+// ❌ NewLazyCommandNameMismatchNativeError
+
+// NewLazyCommandNameMismatchNativeError, command created by factory does not
+// match the name it was registered with.
+func NewLazyCommandNameMismatchNativeError(name, actual string) error {
+	return newNativeError(fmt.Errorf(
+		"cobra container: lazy command '%v' created with mismatched name: '%v'",
+		name, actual,
+	))
+}
+
+// ❌ NewShortFlagCollisionNativeError
+
+// NewShortFlagCollisionNativeError, short flag used by more than 1 flag
+// visible to a command.
+func NewShortFlagCollisionNativeError(short, first, firstOwner, second, secondOwner string) error {
+	return newNativeError(fmt.Errorf(
+		"short flag '-%v' of '--%v' (%v) collides with '--%v' (%v)",
+		short, first, firstOwner, second, secondOwner,
+	))
+}
+
+// ❌ NewInvalidQuantityBoundNativeError
+
+// NewInvalidQuantityBoundNativeError, bound passed to a quantity validation
+// helper can't be parsed.
+func NewInvalidQuantityBoundNativeError(flag, bound string, reason error) error {
+	return newNativeError(fmt.Errorf(
+		"invalid bound '%v' for flag '%v': %w", bound, flag, reason,
+	))
+}
+
+// ❌ NewInvalidDefaultValueNativeError
+
+// NewInvalidDefaultValueNativeError, default of a flag specified as a string
+// can't be parsed.
+func NewInvalidDefaultValueNativeError(flag, value string, reason error) error {
+	return newNativeError(fmt.Errorf(
+		"invalid default '%v' for flag '%v': %w", value, flag, reason,
+	))
 }
@@ -52,6 +52,12 @@ var _ = Describe("MessagesValidationErrors", Ordered, func() {
 			GinkgoWriter.Printf("VALIDATION-ERROR-RESULT: %v", err)
 			fmt.Printf("⚠️ VALIDATION-ERROR-RESULT(%v): '%v'\n", entry.Name, err)
 			Expect(err).Error().NotTo(BeNil())
+
+			structured, ok := err.(locale.StructuredValidationError)
+			Expect(ok).To(BeTrue())
+			Expect(structured.Category()).To(Equal(locale.ValidationErrorCategory))
+			Expect(structured.Failure().Flag).To(Equal("foo-flag"))
+			Expect(structured.Failure().Params).To(HaveKey("low"))
 		},
 		func(entry validationEntry) string {
 			return fmt.Sprintf("🧪 --> 🔥 native error function: '%v'", entry.Name)
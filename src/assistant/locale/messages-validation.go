@@ -1,6 +1,8 @@
 package locale
 
 import (
+	"strings"
+
 	"github.com/nicksnyder/go-i18n/v2/i18n"
 	"github.com/snivilised/li18ngo"
 )
@@ -44,6 +46,7 @@ type WithinOptValidationBehaviourQuery interface {
 
 type WithinOptValidation struct {
 	li18ngo.LocalisableError
+	validationError
 }
 
 func (e WithinOptValidation) IsOutOfRange() bool {
@@ -62,6 +65,7 @@ func NewWithinOptValidationError(flag string, value, low, high any) WithinOptVal
 				},
 			},
 		},
+		validationError: failure(ConstraintWithin, flag, value, map[string]any{"low": low, "high": high}),
 	}
 }
 
@@ -87,6 +91,7 @@ type NotWithinOptValidationBehaviourQuery interface {
 
 type NotWithinOptValidation struct {
 	li18ngo.LocalisableError
+	validationError
 }
 
 func (e NotWithinOptValidation) IsInsideOfRange() bool {
@@ -105,6 +110,7 @@ func NewNotWithinOptValidationError(flag string, value, low, high any) NotWithin
 				},
 			},
 		},
+		validationError: failure(ConstraintNotWithin, flag, value, map[string]any{"low": low, "high": high}),
 	}
 }
 
@@ -140,6 +146,7 @@ type ContainsOptValidationBehaviourQuery[T any] interface {
 
 type ContainsOptValidation[T any] struct {
 	li18ngo.LocalisableError
+	validationError
 }
 
 func (e ContainsOptValidation[T]) IsAMemberOf() bool {
@@ -157,6 +164,7 @@ func NewContainsOptValidationError[T any](flag string, value any, collection []T
 				},
 			},
 		},
+		validationError: failure(ConstraintContains, flag, value, map[string]any{"collection": collection}),
 	}
 }
 
@@ -182,6 +190,7 @@ type NotContainsOptValidationBehaviourQuery[T any] interface {
 
 type NotContainsOptValidation[T any] struct {
 	li18ngo.LocalisableError
+	validationError
 }
 
 func (e NotContainsOptValidation[T]) IsNotAMemberOf() bool {
@@ -199,6 +208,7 @@ func NewNotContainsOptValidationError[T any](flag string, value any, collection
 				},
 			},
 		},
+		validationError: failure(ConstraintNotContains, flag, value, map[string]any{"collection": collection}),
 	}
 }
 
@@ -234,6 +244,7 @@ type MatchOptValidationBehaviourQuery interface {
 
 type MatchOptValidation struct {
 	li18ngo.LocalisableError
+	validationError
 }
 
 func (e MatchOptValidation) IsMatch() bool {
@@ -251,6 +262,7 @@ func NewMatchOptValidationError(flag, value, pattern string) MatchOptValidationB
 				},
 			},
 		},
+		validationError: failure(ConstraintMatch, flag, value, map[string]any{"pattern": pattern}),
 	}
 }
 
@@ -276,6 +288,7 @@ type NotMatchOptValidationBehaviourQuery interface {
 
 type NotMatchOptValidation struct {
 	li18ngo.LocalisableError
+	validationError
 }
 
 func (e NotMatchOptValidation) IsNotMatch() bool {
@@ -293,6 +306,7 @@ func NewNotMatchOptValidationError(flag, value, pattern string) NotMatchOptValid
 				},
 			},
 		},
+		validationError: failure(ConstraintNotMatch, flag, value, map[string]any{"pattern": pattern}),
 	}
 }
 
@@ -326,6 +340,7 @@ type GreaterThanOptValidationBehaviourQuery interface {
 
 type GreaterThanOptValidation struct {
 	li18ngo.LocalisableError
+	validationError
 }
 
 func (e GreaterThanOptValidation) IsGreaterThan() bool {
@@ -343,6 +358,7 @@ func NewGreaterThanOptValidationError(flag string, value, threshold any) Greater
 				},
 			},
 		},
+		validationError: failure(ConstraintGreaterThan, flag, value, map[string]any{"threshold": threshold}),
 	}
 }
 
@@ -368,6 +384,7 @@ type AtLeastOptValidationBehaviourQuery interface {
 
 type AtLeastOptValidation struct {
 	li18ngo.LocalisableError
+	validationError
 }
 
 func (e AtLeastOptValidation) IsAtLeast() bool {
@@ -385,6 +402,7 @@ func NewAtLeastOptValidationError(flag string, value, threshold any) AtLeastOptV
 				},
 			},
 		},
+		validationError: failure(ConstraintAtLeast, flag, value, map[string]any{"threshold": threshold}),
 	}
 }
 
@@ -410,6 +428,7 @@ type LessThanOptValidationBehaviourQuery interface {
 
 type LessThanOptValidation struct {
 	li18ngo.LocalisableError
+	validationError
 }
 
 func (e LessThanOptValidation) IsLessThan() bool {
@@ -427,6 +446,7 @@ func NewLessThanOptValidationError(flag string, value, threshold any) LessThanOp
 				},
 			},
 		},
+		validationError: failure(ConstraintLessThan, flag, value, map[string]any{"threshold": threshold}),
 	}
 }
 
@@ -452,6 +472,7 @@ type AtMostOptValidationBehaviourQuery interface {
 
 type AtMostOptValidation struct {
 	li18ngo.LocalisableError
+	validationError
 }
 
 func (e AtMostOptValidation) IsAtMost() bool {
@@ -469,6 +490,7 @@ func NewAtMostOptValidationError(flag string, value, threshold any) AtMostOptVal
 				},
 			},
 		},
+		validationError: failure(ConstraintAtMost, flag, value, map[string]any{"threshold": threshold}),
 	}
 }
 
@@ -495,6 +517,7 @@ type InvalidExtendedGlobFilterBehaviourQuery interface {
 
 type InvalidExtendedGlobFilterValidation struct {
 	li18ngo.LocalisableError
+	validationError
 }
 
 func (e InvalidExtendedGlobFilterValidation) IsInvalidExtendedGlobFilter() bool {
@@ -508,5 +531,721 @@ func NewInvalidExtendedGlobFilterValidationError(delimiter string) InvalidExtend
 				Delimiter: delimiter,
 			},
 		},
+		validationError: failure(ConstraintGlobFilter, "", nil, map[string]any{"delimiter": delimiter}),
+	}
+}
+
+// 💧 ParamSetResolutionOV
+type ParamSetResolutionOV struct {
+	CobrassTemplData
+	Command string
+}
+
+// ❌ AmbiguousParamSetTemplData
+
+// AmbiguousParamSetTemplData
+type AmbiguousParamSetTemplData struct {
+	ParamSetResolutionOV
+	Candidates string
+}
+
+func (td AmbiguousParamSetTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "ambiguous-param-set.cobrass",
+		Description: "Parameter set resolution failed, because more than one parameter set matches the flags provided.",
+		Other:       "({{.Command}}): parameter set cannot be resolved, ambiguous between: '{{.Candidates}}'",
+	}
+}
+
+type AmbiguousParamSetBehaviourQuery interface {
+	error
+	IsAmbiguousParamSet() bool
+}
+
+type AmbiguousParamSetValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e AmbiguousParamSetValidation) IsAmbiguousParamSet() bool {
+	return true
+}
+
+func NewAmbiguousParamSetValidationError(command string, candidates []string) AmbiguousParamSetBehaviourQuery {
+	return &AmbiguousParamSetValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: AmbiguousParamSetTemplData{
+				ParamSetResolutionOV: ParamSetResolutionOV{
+					Command: command,
+				},
+				Candidates: strings.Join(candidates, ", "),
+			},
+		},
+		validationError: failure(ConstraintAmbiguousParamSet, "", nil, map[string]any{
+			"command": command, "candidates": candidates,
+		}),
+	}
+}
+
+// ❌ NoMatchingParamSetTemplData
+
+// NoMatchingParamSetTemplData
+type NoMatchingParamSetTemplData struct {
+	ParamSetResolutionOV
+	Flags string
+}
+
+func (td NoMatchingParamSetTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "no-matching-param-set.cobrass",
+		Description: "Parameter set resolution failed, because no parameter set matches the flags provided.",
+		Other:       "({{.Command}}): parameter set cannot be resolved, no parameter set matches flags: '{{.Flags}}'",
+	}
+}
+
+type NoMatchingParamSetBehaviourQuery interface {
+	error
+	IsNoMatchingParamSet() bool
+}
+
+type NoMatchingParamSetValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e NoMatchingParamSetValidation) IsNoMatchingParamSet() bool {
+	return true
+}
+
+func NewNoMatchingParamSetValidationError(command string, flags []string) NoMatchingParamSetBehaviourQuery {
+	return &NoMatchingParamSetValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: NoMatchingParamSetTemplData{
+				ParamSetResolutionOV: ParamSetResolutionOV{
+					Command: command,
+				},
+				Flags: strings.Join(flags, ", "),
+			},
+		},
+		validationError: failure(ConstraintNoMatchingParamSet, "", nil, map[string]any{
+			"command": command, "flags": flags,
+		}),
+	}
+}
+
+// 💧 RequiredOV
+type RequiredOV struct {
+	CobrassTemplData
+	Flag string
+}
+
+// ❌ MissingRequiredOptionTemplData
+
+// MissingRequiredOptionTemplData
+type MissingRequiredOptionTemplData struct {
+	RequiredOV
+}
+
+func (td MissingRequiredOptionTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "missing-required-option.cobrass",
+		Description: "Required option validation has failed, because the option has not been provided.",
+		Other:       "({{.Flag}}): option validation failed, option is required",
+	}
+}
+
+type MissingRequiredOptionBehaviourQuery interface {
+	error
+	IsMissingRequiredOption() bool
+}
+
+type MissingRequiredOptionValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e MissingRequiredOptionValidation) IsMissingRequiredOption() bool {
+	return true
+}
+
+func NewMissingRequiredOptionValidationError(flag string) MissingRequiredOptionBehaviourQuery {
+	return &MissingRequiredOptionValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: MissingRequiredOptionTemplData{
+				RequiredOV: RequiredOV{
+					Flag: flag,
+				},
+			},
+		},
+		validationError: failure(ConstraintRequired, flag, nil, nil),
+	}
+}
+
+// ❌ MissingRequiredIfOptionTemplData
+
+// MissingRequiredIfOptionTemplData
+type MissingRequiredIfOptionTemplData struct {
+	RequiredOV
+	Dependency string
+	Value      any
+}
+
+func (td MissingRequiredIfOptionTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "missing-required-if-option.cobrass",
+		Description: "Conditionally required option validation has failed, because the option has not been provided.",
+		Other:       "({{.Flag}}): option validation failed, option is required when '{{.Dependency}}' is '{{.Value}}'",
+	}
+}
+
+type MissingRequiredIfOptionBehaviourQuery interface {
+	error
+	IsMissingRequiredIfOption() bool
+}
+
+type MissingRequiredIfOptionValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e MissingRequiredIfOptionValidation) IsMissingRequiredIfOption() bool {
+	return true
+}
+
+func NewMissingRequiredIfOptionValidationError(flag, dependency string, value any) MissingRequiredIfOptionBehaviourQuery {
+	return &MissingRequiredIfOptionValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: MissingRequiredIfOptionTemplData{
+				RequiredOV: RequiredOV{
+					Flag: flag,
+				},
+				Dependency: dependency,
+				Value:      value,
+			},
+		},
+		validationError: failure(ConstraintRequiredIf, flag, nil, map[string]any{
+			"dependency": dependency, "value": value,
+		}),
+	}
+}
+
+// ❌ InvalidTimeValueTemplData
+
+// InvalidTimeValueTemplData
+type InvalidTimeValueTemplData struct {
+	CobrassTemplData
+	Value   string
+	Layouts string
+}
+
+func (td InvalidTimeValueTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "invalid-time-value.cobrass",
+		Description: "Time value can't be parsed, because it doesn't match any of the layouts or relative forms.",
+		Other:       "'{{.Value}}' is not a valid time, expected a relative time eg '-3d' or one of layouts: '{{.Layouts}}'",
+	}
+}
+
+type InvalidTimeValueBehaviourQuery interface {
+	error
+	IsInvalidTimeValue() bool
+}
+
+type InvalidTimeValueValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e InvalidTimeValueValidation) IsInvalidTimeValue() bool {
+	return true
+}
+
+func NewInvalidTimeValueValidationError(value string, layouts []string) InvalidTimeValueBehaviourQuery {
+	return &InvalidTimeValueValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: InvalidTimeValueTemplData{
+				Value:   value,
+				Layouts: strings.Join(layouts, ", "),
+			},
+		},
+		validationError: failure(ConstraintTime, "", value, map[string]any{"layouts": layouts}),
+	}
+}
+
+// ❌ URLSchemeOptValidationTemplData
+
+// URLSchemeOptValidationTemplData
+type URLSchemeOptValidationTemplData struct {
+	CobrassTemplData
+	Flag    string
+	Value   string
+	Scheme  string
+	Schemes string
+}
+
+func (td URLSchemeOptValidationTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "ov-failed-url-scheme.cobrass",
+		Description: "'URL scheme' Option validation has failed, because the scheme of the url is not allowed.",
+		Other:       "({{.Flag}}): option validation failed, '{{.Value}}', scheme '{{.Scheme}}' is not one of: '{{.Schemes}}'",
+	}
+}
+
+type URLSchemeOptValidationBehaviourQuery interface {
+	error
+	IsURLSchemeNotAllowed() bool
+}
+
+type URLSchemeOptValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e URLSchemeOptValidation) IsURLSchemeNotAllowed() bool {
+	return true
+}
+
+func NewURLSchemeOptValidationError(flag, value, scheme string, schemes []string) URLSchemeOptValidationBehaviourQuery {
+	return &URLSchemeOptValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: URLSchemeOptValidationTemplData{
+				Flag:    flag,
+				Value:   value,
+				Scheme:  scheme,
+				Schemes: strings.Join(schemes, ", "),
+			},
+		},
+		validationError: failure(ConstraintURLScheme, flag, value, map[string]any{"schemes": schemes}),
+	}
+}
+
+// ❌ InvalidQuantityValueTemplData
+
+// InvalidQuantityValueTemplData
+type InvalidQuantityValueTemplData struct {
+	CobrassTemplData
+	Value   string
+	Example string
+}
+
+func (td InvalidQuantityValueTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "invalid-quantity-value.cobrass",
+		Description: "Quantity value can't be parsed, because its number or unit is not valid.",
+		Other:       "'{{.Value}}' is not a valid quantity, expected eg: '{{.Example}}'",
+	}
+}
+
+type InvalidQuantityValueBehaviourQuery interface {
+	error
+	IsInvalidQuantityValue() bool
+}
+
+type InvalidQuantityValueValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e InvalidQuantityValueValidation) IsInvalidQuantityValue() bool {
+	return true
+}
+
+func NewInvalidQuantityValueValidationError(value, example string) InvalidQuantityValueBehaviourQuery {
+	return &InvalidQuantityValueValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: InvalidQuantityValueTemplData{
+				Value:   value,
+				Example: example,
+			},
+		},
+		validationError: failure(ConstraintQuantity, "", value, map[string]any{"example": example}),
+	}
+}
+
+// ❌ InvalidRangeValueTemplData
+
+// InvalidRangeValueTemplData
+type InvalidRangeValueTemplData struct {
+	CobrassTemplData
+	Value   string
+	Example string
+}
+
+func (td InvalidRangeValueTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "invalid-range-value.cobrass",
+		Description: "Range value can't be parsed, because a bound is not valid or low is greater than high.",
+		Other:       "'{{.Value}}' is not a valid range, expected 'low..high' where low <= high, eg: '{{.Example}}'",
+	}
+}
+
+type InvalidRangeValueBehaviourQuery interface {
+	error
+	IsInvalidRangeValue() bool
+}
+
+type InvalidRangeValueValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e InvalidRangeValueValidation) IsInvalidRangeValue() bool {
+	return true
+}
+
+func NewInvalidRangeValueValidationError(value, example string) InvalidRangeValueBehaviourQuery {
+	return &InvalidRangeValueValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: InvalidRangeValueTemplData{
+				Value:   value,
+				Example: example,
+			},
+		},
+		validationError: failure(ConstraintRange, "", value, map[string]any{"example": example}),
+	}
+}
+
+// ❌ InvalidMapEntryTemplData
+
+// InvalidMapEntryTemplData
+type InvalidMapEntryTemplData struct {
+	CobrassTemplData
+	Value string
+}
+
+func (td InvalidMapEntryTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "invalid-map-entry.cobrass",
+		Description: "Map entry can't be parsed, because it is not of the form key=value or the key or value is not valid.",
+		Other:       "'{{.Value}}' is not a valid map entry, expected 'key=value'",
+	}
+}
+
+type InvalidMapEntryBehaviourQuery interface {
+	error
+	IsInvalidMapEntry() bool
+}
+
+type InvalidMapEntryValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e InvalidMapEntryValidation) IsInvalidMapEntry() bool {
+	return true
+}
+
+func NewInvalidMapEntryValidationError(flag, value string) InvalidMapEntryBehaviourQuery {
+	return &InvalidMapEntryValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: InvalidMapEntryTemplData{
+				Value: value,
+			},
+		},
+		validationError: failure(ConstraintMapEntry, flag, value, nil),
+	}
+}
+
+// ❌ UnknownThirdPartyFlagTemplData
+
+// UnknownThirdPartyFlagTemplData
+type UnknownThirdPartyFlagTemplData struct {
+	CobrassTemplData
+	Flag string
+}
+
+func (td UnknownThirdPartyFlagTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "unknown-third-party-flag.cobrass",
+		Description: "Flag destined for a third party command is not described by its schema.",
+		Other:       "({{.Flag}}): third party flag is not recognised",
+	}
+}
+
+type UnknownThirdPartyFlagBehaviourQuery interface {
+	error
+	IsUnknownThirdPartyFlag() bool
+}
+
+type UnknownThirdPartyFlagValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e UnknownThirdPartyFlagValidation) IsUnknownThirdPartyFlag() bool {
+	return true
+}
+
+func NewUnknownThirdPartyFlagValidationError(flag string) UnknownThirdPartyFlagBehaviourQuery {
+	return &UnknownThirdPartyFlagValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: UnknownThirdPartyFlagTemplData{
+				Flag: flag,
+			},
+		},
+		validationError: failure(ConstraintThirdPartyFlag, flag, nil, nil),
+	}
+}
+
+// ❌ MissingThirdPartyValueTemplData
+
+// MissingThirdPartyValueTemplData
+type MissingThirdPartyValueTemplData struct {
+	CobrassTemplData
+	Flag string
+}
+
+func (td MissingThirdPartyValueTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "missing-third-party-value.cobrass",
+		Description: "Third party flag that takes a value has been specified without one.",
+		Other:       "({{.Flag}}): third party flag requires a value",
+	}
+}
+
+type MissingThirdPartyValueBehaviourQuery interface {
+	error
+	IsMissingThirdPartyValue() bool
+}
+
+type MissingThirdPartyValueValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e MissingThirdPartyValueValidation) IsMissingThirdPartyValue() bool {
+	return true
+}
+
+func NewMissingThirdPartyValueValidationError(flag string) MissingThirdPartyValueBehaviourQuery {
+	return &MissingThirdPartyValueValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: MissingThirdPartyValueTemplData{
+				Flag: flag,
+			},
+		},
+		validationError: failure(ConstraintThirdPartyArity, flag, nil, nil),
+	}
+}
+
+// ❌ UnexpectedThirdPartyValueTemplData
+
+// UnexpectedThirdPartyValueTemplData
+type UnexpectedThirdPartyValueTemplData struct {
+	CobrassTemplData
+	Flag  string
+	Value string
+}
+
+func (td UnexpectedThirdPartyValueTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "unexpected-third-party-value.cobrass",
+		Description: "Third party switch, that does not take a value, has been specified with one.",
+		Other:       "({{.Flag}}): third party switch does not take a value, found: '{{.Value}}'",
+	}
+}
+
+type UnexpectedThirdPartyValueBehaviourQuery interface {
+	error
+	IsUnexpectedThirdPartyValue() bool
+}
+
+type UnexpectedThirdPartyValueValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e UnexpectedThirdPartyValueValidation) IsUnexpectedThirdPartyValue() bool {
+	return true
+}
+
+func NewUnexpectedThirdPartyValueValidationError(flag, value string) UnexpectedThirdPartyValueBehaviourQuery {
+	return &UnexpectedThirdPartyValueValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: UnexpectedThirdPartyValueTemplData{
+				Flag:  flag,
+				Value: value,
+			},
+		},
+		validationError: failure(ConstraintThirdPartyArity, flag, value, nil),
+	}
+}
+
+// ❌ InvalidThirdPartyValueTemplData
+
+// InvalidThirdPartyValueTemplData
+type InvalidThirdPartyValueTemplData struct {
+	CobrassTemplData
+	Flag  string
+	Value string
+	Type  string
+}
+
+func (td InvalidThirdPartyValueTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "invalid-third-party-value.cobrass",
+		Description: "Option value of third party flag can't be parsed as the type declared by its schema.",
+		Other:       "({{.Flag}}): third party option value '{{.Value}}' is not a valid {{.Type}}",
+	}
+}
+
+type InvalidThirdPartyValueBehaviourQuery interface {
+	error
+	IsInvalidThirdPartyValue() bool
+}
+
+type InvalidThirdPartyValueValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e InvalidThirdPartyValueValidation) IsInvalidThirdPartyValue() bool {
+	return true
+}
+
+func NewInvalidThirdPartyValueValidationError(flag, value, typeName string) InvalidThirdPartyValueBehaviourQuery {
+	return &InvalidThirdPartyValueValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: InvalidThirdPartyValueTemplData{
+				Flag:  flag,
+				Value: value,
+				Type:  typeName,
+			},
+		},
+		validationError: failure(ConstraintThirdPartyType, flag, value, map[string]any{"type": typeName}),
+	}
+}
+
+// ❌ NotAcceptableThirdPartyValueTemplData
+
+// NotAcceptableThirdPartyValueTemplData
+type NotAcceptableThirdPartyValueTemplData struct {
+	CobrassTemplData
+	Flag        string
+	Value       string
+	Acceptables []string
+}
+
+func (td NotAcceptableThirdPartyValueTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "not-acceptable-third-party-value.cobrass",
+		Description: "Option value of third party flag is not one of the values allowed by its schema.",
+		Other:       "({{.Flag}}): third party option value '{{.Value}}' is not one of: {{.Acceptables}}",
+	}
+}
+
+type NotAcceptableThirdPartyValueBehaviourQuery interface {
+	error
+	IsNotAcceptableThirdPartyValue() bool
+}
+
+type NotAcceptableThirdPartyValueValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e NotAcceptableThirdPartyValueValidation) IsNotAcceptableThirdPartyValue() bool {
+	return true
+}
+
+func NewNotAcceptableThirdPartyValueValidationError(flag, value string,
+	acceptables []string,
+) NotAcceptableThirdPartyValueBehaviourQuery {
+	return &NotAcceptableThirdPartyValueValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: NotAcceptableThirdPartyValueTemplData{
+				Flag:        flag,
+				Value:       value,
+				Acceptables: acceptables,
+			},
+		},
+		validationError: failure(ConstraintThirdPartyAcceptables, flag, value,
+			map[string]any{"acceptables": acceptables},
+		),
+	}
+}
+
+// ❌ RepeatedThirdPartyFlagTemplData
+
+// RepeatedThirdPartyFlagTemplData
+type RepeatedThirdPartyFlagTemplData struct {
+	CobrassTemplData
+	Flag string
+}
+
+func (td RepeatedThirdPartyFlagTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "repeated-third-party-flag.cobrass",
+		Description: "Third party flag that is not repeatable has been specified more than once.",
+		Other:       "({{.Flag}}): third party flag may only be specified once",
+	}
+}
+
+type RepeatedThirdPartyFlagBehaviourQuery interface {
+	error
+	IsRepeatedThirdPartyFlag() bool
+}
+
+type RepeatedThirdPartyFlagValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e RepeatedThirdPartyFlagValidation) IsRepeatedThirdPartyFlag() bool {
+	return true
+}
+
+func NewRepeatedThirdPartyFlagValidationError(flag string) RepeatedThirdPartyFlagBehaviourQuery {
+	return &RepeatedThirdPartyFlagValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: RepeatedThirdPartyFlagTemplData{
+				Flag: flag,
+			},
+		},
+		validationError: failure(ConstraintThirdPartyRepeat, flag, nil, nil),
+	}
+}
+
+// ❌ FlagParseValidationTemplData
+
+// FlagParseValidationTemplData
+type FlagParseValidationTemplData struct {
+	CobrassTemplData
+	Reason string
+}
+
+func (td FlagParseValidationTemplData) Message() *i18n.Message {
+	return &i18n.Message{
+		ID:          "flag-parse-failed.cobrass",
+		Description: "The command line could not be parsed, eg a flag is unknown or its value is not of the flag's type.",
+		Other:       "flag parsing failed: {{.Reason}}",
+	}
+}
+
+type FlagParseValidationBehaviourQuery interface {
+	error
+	IsFlagParseFailure() bool
+}
+
+type FlagParseValidation struct {
+	li18ngo.LocalisableError
+	validationError
+}
+
+func (e FlagParseValidation) IsFlagParseFailure() bool {
+	return true
+}
+
+// NewFlagParseValidationError creates the error for a failure to parse the
+// command line, where reason is the error reported by the parser. The flag
+// is empty and the value is nil, when they can not be determined from reason.
+func NewFlagParseValidationError(flag string, value any, reason string) FlagParseValidationBehaviourQuery {
+	return &FlagParseValidation{
+		LocalisableError: li18ngo.LocalisableError{
+			Data: FlagParseValidationTemplData{
+				Reason: reason,
+			},
+		},
+		validationError: failure(ConstraintFlagParse, flag, value, nil),
 	}
 }
@@ -0,0 +1,351 @@
+package assistant
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/snivilised/cobrass/src/internal/third/lo"
+)
+
+// ChangeKind identifies the type of breaking change detected between two
+// manifests.
+type ChangeKind string
+
+const (
+	// CommandRemovedChange a command present in the previous manifest is missing
+	CommandRemovedChange ChangeKind = "command-removed"
+
+	// FlagRemovedChange a flag present in the previous manifest is missing
+	FlagRemovedChange ChangeKind = "flag-removed"
+
+	// ShortChangedChange the short name of a flag has been changed or removed
+	ShortChangedChange ChangeKind = "short-changed"
+
+	// TypeChangedChange the type of a flag has changed
+	TypeChangedChange ChangeKind = "type-changed"
+
+	// PersistenceChangedChange a persistent flag is no longer persistent
+	PersistenceChangedChange ChangeKind = "persistence-changed"
+
+	// DefaultChangedChange the default value of a flag has changed
+	DefaultChangedChange ChangeKind = "default-changed"
+
+	// ValidatorNarrowedChange the validator of a flag now rejects values
+	// previously accepted
+	ValidatorNarrowedChange ChangeKind = "validator-narrowed"
+
+	// EnumAliasRemovedChange an acceptable value of an enum flag is missing
+	EnumAliasRemovedChange ChangeKind = "enum-alias-removed"
+)
+
+// BreakingChange describes a single incompatibility between the cli
+// surface described by 2 manifests.
+type BreakingChange struct {
+	// Kind of change
+	//
+	Kind ChangeKind `json:"kind"`
+
+	// Command is the path of the command affected
+	//
+	Command string `json:"command"`
+
+	// Flag is the name of the flag affected, empty if the change
+	// is in regard to the command itself.
+	//
+	Flag string `json:"flag,omitempty"`
+
+	// Previous describes the state in the previous manifest
+	//
+	Previous string `json:"previous,omitempty"`
+
+	// Current describes the state in the current manifest
+	//
+	Current string `json:"current,omitempty"`
+}
+
+func (c BreakingChange) String() string {
+	subject := lo.Ternary(c.Flag == "",
+		fmt.Sprintf("'%v'", c.Command),
+		fmt.Sprintf("'%v --%v'", c.Command, c.Flag),
+	)
+
+	return fmt.Sprintf("%v: %v (previous: '%v', current: '%v')",
+		c.Kind, subject, c.Previous, c.Current,
+	)
+}
+
+// CheckCompatibility compares the current manifest against a previous one
+// and reports the changes that could break existing invocations of the
+// cli, ie removed commands/flags, changed short names, types and defaults,
+// narrowed validators and removed enum aliases. Additions are not
+// reported as they are backwards compatible.
+func CheckCompatibility(previous, current *Manifest) []BreakingChange {
+	changes := []BreakingChange{}
+
+	if previous == nil || previous.Root == nil {
+		return changes
+	}
+
+	var currentRoot *CommandManifest
+	if current != nil {
+		currentRoot = current.Root
+	}
+
+	return compareCommands(previous.Root, currentRoot, changes)
+}
+
+func compareCommands(previous, current *CommandManifest, changes []BreakingChange) []BreakingChange {
+	if current == nil {
+		return append(changes, BreakingChange{
+			Kind:     CommandRemovedChange,
+			Command:  previous.Path,
+			Previous: previous.Path,
+		})
+	}
+
+	for _, pf := range previous.Flags {
+		cf, found := lo.Find(current.Flags, func(f *FlagManifest) bool {
+			return f.Name == pf.Name
+		})
+
+		if !found {
+			changes = append(changes, BreakingChange{
+				Kind:     FlagRemovedChange,
+				Command:  previous.Path,
+				Flag:     pf.Name,
+				Previous: pf.Name,
+			})
+
+			continue
+		}
+
+		changes = compareFlags(previous.Path, pf, cf, changes)
+	}
+
+	for _, pc := range previous.Commands {
+		cc, _ := lo.Find(current.Commands, func(c *CommandManifest) bool {
+			return c.Name == pc.Name
+		})
+
+		changes = compareCommands(pc, cc, changes)
+	}
+
+	return changes
+}
+
+func compareFlags(path string, previous, current *FlagManifest, changes []BreakingChange) []BreakingChange {
+	change := func(kind ChangeKind, p, c string) {
+		changes = append(changes, BreakingChange{
+			Kind:     kind,
+			Command:  path,
+			Flag:     previous.Name,
+			Previous: p,
+			Current:  c,
+		})
+	}
+
+	if previous.Short != "" && previous.Short != current.Short {
+		change(ShortChangedChange, previous.Short, current.Short)
+	}
+
+	if previous.Type != current.Type {
+		change(TypeChangedChange, previous.Type, current.Type)
+	}
+
+	if previous.Persistent && !current.Persistent {
+		change(PersistenceChangedChange, "persistent", "local")
+	}
+
+	if previous.Default != current.Default {
+		change(DefaultChangedChange, previous.Default, current.Default)
+	}
+
+	if isNarrowed(previous.Validator, current.Validator) {
+		change(ValidatorNarrowedChange,
+			describeConstraint(previous.Validator), describeConstraint(current.Validator),
+		)
+	}
+
+	remaining := []string{}
+	for _, aliases := range current.Acceptables {
+		remaining = append(remaining, aliases...)
+	}
+
+	primes := lo.Keys(previous.Acceptables)
+	slices.Sort(primes)
+
+	for _, prime := range primes {
+		for _, alias := range previous.Acceptables[prime] {
+			if !slices.Contains(remaining, alias) {
+				change(EnumAliasRemovedChange, alias, "")
+			}
+		}
+	}
+
+	return changes
+}
+
+func describeConstraint(constraint *ValidatorConstraint) string {
+	if constraint == nil {
+		return ""
+	}
+
+	names := lo.Keys(constraint.Params)
+	slices.Sort(names)
+
+	params := lo.Map(names, func(name string, _ int) string {
+		return fmt.Sprintf("%v=%v", name, constraint.Params[name])
+	})
+
+	return fmt.Sprintf("%v(%v)", constraint.Kind, strings.Join(params, ", "))
+}
+
+// isNarrowed determines whether the current constraint rejects values that
+// the previous constraint accepted. When this can't be determined, because
+// the kind of constraint has changed or it is a custom validator, the
+// change is deemed to be narrowing unless the previous validator was custom.
+func isNarrowed(previous, current *ValidatorConstraint) bool {
+	if current == nil {
+		return false
+	}
+
+	if previous == nil {
+		return true
+	}
+
+	if previous.Kind != current.Kind {
+		return previous.Kind != ConstraintCustom
+	}
+
+	param := func(constraint *ValidatorConstraint, name string) any {
+		return constraint.Params[name]
+	}
+
+	// an open bound is absent and is unbounded
+	//
+	bound := func(constraint *ValidatorConstraint, name string, open float64) any {
+		if value, found := constraint.Params[name]; found {
+			return value
+		}
+
+		return open
+	}
+	low := func(constraint *ValidatorConstraint) any {
+		return bound(constraint, "low", math.Inf(-1))
+	}
+	high := func(constraint *ValidatorConstraint) any {
+		return bound(constraint, "high", math.Inf(1))
+	}
+	excludesHigh := func(constraint *ValidatorConstraint) bool {
+		excluded, _ := constraint.Params["excludeHigh"].(bool)
+
+		return excluded
+	}
+
+	// parameters that can't be compared are deemed to narrow when changed
+	//
+	precedes := func(a, b any) bool {
+		less, comparable := isLess(a, b)
+
+		return lo.Ternary(comparable, less, !reflect.DeepEqual(a, b))
+	}
+
+	switch current.Kind {
+	case ConstraintWithin:
+		return precedes(low(previous), low(current)) ||
+			precedes(high(current), high(previous)) ||
+			excludesHigh(current) && !excludesHigh(previous) && !precedes(high(previous), high(current))
+
+	case ConstraintNotWithin:
+		return precedes(low(current), low(previous)) ||
+			precedes(high(previous), high(current))
+
+	case ConstraintGreaterThan, ConstraintAtLeast:
+		return precedes(param(previous, "threshold"), param(current, "threshold"))
+
+	case ConstraintLessThan, ConstraintAtMost:
+		return precedes(param(current, "threshold"), param(previous, "threshold"))
+
+	case ConstraintContains:
+		return !isSubset(param(previous, "collection"), param(current, "collection"))
+
+	case ConstraintNotContains:
+		return !isSubset(param(current, "collection"), param(previous, "collection"))
+
+	case ConstraintMatch, ConstraintNotMatch:
+		return param(previous, "pattern") != param(current, "pattern")
+
+	case ConstraintCustom:
+		return false
+	}
+
+	return false
+}
+
+// isLess compares 2 constraint parameters. Parameters in a manifest that has
+// been read back from json are float64, whereas those acquired directly from
+// the container retain their native type, so numbers are compared as float64.
+// Parameters that are not numeric are not comparable, since their textual
+// form does not reflect their order, eg "900MiB" and "1GiB".
+func isLess(a, b any) (less, comparable bool) {
+	fa, aNumeric := asFloat(a)
+	fb, bNumeric := asFloat(b)
+
+	if aNumeric && bNumeric {
+		return fa < fb, true
+	}
+
+	return false, false
+}
+
+func asFloat(value any) (float64, bool) {
+	v := reflect.ValueOf(value)
+
+	switch v.Kind() { //nolint:exhaustive // only numeric kinds are of interest
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+
+	return 0, false
+}
+
+// isSubset determines whether all the members of collection a are also
+// members of collection b.
+func isSubset(a, b any) bool {
+	members := func(collection any) []string {
+		v := reflect.ValueOf(collection)
+
+		if v.Kind() != reflect.Slice {
+			return []string{}
+		}
+
+		result := make([]string, 0, v.Len())
+
+		for i := range v.Len() {
+			item := v.Index(i).Interface()
+
+			if f, numeric := asFloat(item); numeric {
+				result = append(result, fmt.Sprint(f))
+			} else {
+				result = append(result, fmt.Sprint(item))
+			}
+		}
+
+		return result
+	}
+
+	bm := members(b)
+
+	return lo.EveryBy(members(a), func(item string) bool {
+		return slices.Contains(bm, item)
+	})
+}
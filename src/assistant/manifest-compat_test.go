@@ -0,0 +1,128 @@
+package assistant_test
+
+import (
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant"
+)
+
+type compatibilitySetup struct {
+	short   string
+	def     int
+	low     int
+	high    int
+	formats assistant.AcceptableEnumValues[OutputFormatEnum]
+	gadget  bool
+}
+
+func buildManifest(setup *compatibilitySetup) *assistant.Manifest {
+	rootCommand := &cobra.Command{Use: "poke"}
+	widgetCommand := &cobra.Command{Use: "widget"}
+	container := assistant.NewCobraContainer(rootCommand)
+	container.MustRegisterRootedCommand(widgetCommand)
+
+	if setup.gadget {
+		container.MustRegisterRootedCommand(&cobra.Command{Use: "gadget"})
+	}
+
+	paramSet := assistant.NewParamSet[WidgetParameterSet](widgetCommand)
+	paramSet.BindValidatedIntWithin(
+		assistant.NewFlagInfo("offset is the offset", setup.short, setup.def),
+		&paramSet.Native.Offset, setup.low, setup.high,
+	)
+
+	formatInfo := assistant.NewEnumInfo(setup.formats)
+	formatValue := formatInfo.NewValue()
+	paramSet.BindEnum(
+		assistant.NewFlagInfo("format the output format", "f", "xml"),
+		&formatValue.Source,
+	).AttachEnum("format", formatInfo)
+
+	container.MustRegisterParamSet("widget-ps", paramSet)
+
+	// round trip via json, to ensure comparison works with manifests that
+	// have been read from file.
+	//
+	data, _ := container.Manifest().Marshal()
+	manifest, _ := assistant.UnmarshalManifest(data)
+
+	return manifest
+}
+
+var _ = Describe("CheckCompatibility", func() {
+	var previous *compatibilitySetup
+
+	BeforeEach(func() {
+		previous = &compatibilitySetup{
+			short:   "o",
+			def:     0,
+			low:     0,
+			high:    10,
+			formats: AcceptableOutputFormats,
+			gadget:  true,
+		}
+	})
+
+	Context("given: identical manifests", func() {
+		It("🧪 should: not report any changes", func() {
+			current := *previous
+			Expect(assistant.CheckCompatibility(
+				buildManifest(previous), buildManifest(&current),
+			)).To(BeEmpty())
+		})
+	})
+
+	Context("given: only additions and widening", func() {
+		It("🧪 should: not report any changes", func() {
+			previous.gadget = false
+			current := *previous
+			current.gadget = true
+			current.low = -5
+			current.high = 20
+
+			Expect(assistant.CheckCompatibility(
+				buildManifest(previous), buildManifest(&current),
+			)).To(BeEmpty())
+		})
+	})
+
+	Context("given: breaking changes", func() {
+		It("🧪 should: report all changes", func() {
+			current := *previous
+			current.short = "O"
+			current.def = 1
+			current.high = 5
+			current.gadget = false
+			current.formats = assistant.AcceptableEnumValues[OutputFormatEnum]{
+				XMLFormatEn:  []string{"xml", "x"},
+				JSONFormatEn: []string{"json"},
+			}
+
+			changes := assistant.CheckCompatibility(
+				buildManifest(previous), buildManifest(&current),
+			)
+			kinds := make([]assistant.ChangeKind, 0, len(changes))
+
+			for _, change := range changes {
+				GinkgoWriter.Printf("===> 💥 %v\n", change)
+				kinds = append(kinds, change.Kind)
+			}
+
+			Expect(kinds).To(HaveExactElements(
+				assistant.CommandRemovedChange,
+				assistant.EnumAliasRemovedChange, // j
+				assistant.EnumAliasRemovedChange, // scribble
+				assistant.EnumAliasRemovedChange, // scribbler
+				assistant.EnumAliasRemovedChange, // scr
+				assistant.EnumAliasRemovedChange, // text
+				assistant.EnumAliasRemovedChange, // tx
+				assistant.ShortChangedChange,
+				assistant.DefaultChangedChange,
+				assistant.ValidatorNarrowedChange,
+			))
+			Expect(changes[0].Command).To(Equal("poke gadget"))
+		})
+	})
+})
@@ -0,0 +1,228 @@
+package assistant
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/snivilised/cobrass/src/internal/third/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	// ManifestSchema is the version of the manifest document format. It is
+	// incremented whenever a change is made to the document that would
+	// prevent an older manifest from being compared with a newer one.
+	ManifestSchema = 1
+
+	// cobra does not export the name of this annotation
+	mutuallyExclusiveAnnotation = "cobra_annotation_mutually_exclusive"
+)
+
+// Manifest is a stable, serialisable description of the command tree
+// managed by the CobraContainer. It is intended to drive documentation,
+// to enable the cli surface of different releases to be compared and to
+// feed external completion tools.
+type Manifest struct {
+	// Schema is the version of the manifest format (see ManifestSchema)
+	//
+	Schema int `json:"schema"`
+
+	// Root describes the root command and by extension, the whole tree
+	//
+	Root *CommandManifest `json:"root"`
+}
+
+// CommandManifest describes a single command in the tree.
+type CommandManifest struct {
+	// Name of the command as returned by cobra's Name()
+	//
+	Name string `json:"name"`
+
+	// Path is the full path of the command from the root, eg "root child"
+	//
+	Path string `json:"path"`
+
+	// Use is the one line usage message of the command
+	//
+	Use string `json:"use"`
+
+	// Short is the short description of the command
+	//
+	Short string `json:"short,omitempty"`
+
+	// Aliases are the alternative names of the command
+	//
+	Aliases []string `json:"aliases,omitempty"`
+
+	// Flags defined on the command (sorted by name), not including
+	// those inherited from its ancestors.
+	//
+	Flags []*FlagManifest `json:"flags,omitempty"`
+
+	// MutuallyExclusive contains the groups of flags marked as mutually
+	// exclusive on the command.
+	//
+	MutuallyExclusive [][]string `json:"mutually-exclusive,omitempty"`
+
+	// Commands are the child commands (sorted by name)
+	//
+	Commands []*CommandManifest `json:"commands,omitempty"`
+}
+
+// FlagManifest describes a single flag defined on a command.
+type FlagManifest struct {
+	// Name is the long name of the flag
+	//
+	Name string `json:"name"`
+
+	// Short is the 1 letter shortcut of the flag
+	//
+	Short string `json:"short,omitempty"`
+
+	// Type is the pflag type of the flag, eg "int" or "stringSlice"
+	//
+	Type string `json:"type"`
+
+	// Default is the default value in its string form, which is redacted
+	// if the flag is sensitive.
+	//
+	Default string `json:"default"`
+
+	// Sensitive indicates that the value of the flag is not displayed (see
+	// FlagInfo.Sensitive).
+	//
+	Sensitive bool `json:"sensitive,omitempty"`
+
+	// Persistent indicates the flag is inherited by descendant commands
+	//
+	Persistent bool `json:"persistent"`
+
+	// Usage is the description of the flag
+	//
+	Usage string `json:"usage"`
+
+	// Validator describes the validation attached to the flag, nil
+	// if the flag is not validated.
+	//
+	Validator *ValidatorConstraint `json:"validator,omitempty"`
+
+	// Acceptables contains the acceptable values of an enum flag, keyed
+	// by primary name (see ParamSet.AttachEnum).
+	//
+	Acceptables map[string][]string `json:"acceptables,omitempty"`
+}
+
+// Manifest exports the full command tree, starting at the root. Validators
+// and enum acceptables can only be reported for flags that were bound via
+// a parameter set registered with MustRegisterParamSet.
+func (container *CobraContainer) Manifest() *Manifest {
+	descriptors := make(map[*cobra.Command][]paramSetDescriptor)
+	names := lo.Keys(container.paramSets)
+	slices.Sort(names)
+
+	for _, name := range names {
+		if descriptor, ok := container.paramSets[name].(paramSetDescriptor); ok {
+			command := descriptor.boundCommand()
+			descriptors[command] = append(descriptors[command], descriptor)
+		}
+	}
+
+	return &Manifest{
+		Schema: ManifestSchema,
+		Root:   describeCommand(container.root, descriptors),
+	}
+}
+
+// Marshal renders the manifest as an indented JSON document.
+func (m *Manifest) Marshal() ([]byte, error) {
+	return json.MarshalIndent(m, "", "  ")
+}
+
+// UnmarshalManifest creates a manifest from a JSON document previously
+// created by Manifest.Marshal.
+func UnmarshalManifest(data []byte) (*Manifest, error) {
+	manifest := &Manifest{}
+
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func describeCommand(command *cobra.Command,
+	descriptors map[*cobra.Command][]paramSetDescriptor,
+) *CommandManifest {
+	result := &CommandManifest{
+		Name:    command.Name(),
+		Path:    command.CommandPath(),
+		Use:     command.Use,
+		Short:   command.Short,
+		Aliases: command.Aliases,
+	}
+
+	persistent := command.PersistentFlags()
+	groups := []string{}
+
+	command.LocalFlags().VisitAll(func(flag *pflag.Flag) {
+		result.Flags = append(result.Flags,
+			describeFlag(flag, persistent.Lookup(flag.Name) != nil, descriptors[command]),
+		)
+
+		for _, group := range flag.Annotations[mutuallyExclusiveAnnotation] {
+			if !slices.Contains(groups, group) {
+				groups = append(groups, group)
+			}
+		}
+	})
+
+	slices.Sort(groups)
+
+	for _, group := range groups {
+		result.MutuallyExclusive = append(result.MutuallyExclusive,
+			strings.Split(group, " "),
+		)
+	}
+
+	for _, child := range command.Commands() {
+		result.Commands = append(result.Commands, describeCommand(child, descriptors))
+	}
+
+	slices.SortFunc(result.Commands, func(a, b *CommandManifest) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return result
+}
+
+func describeFlag(flag *pflag.Flag, persistent bool,
+	descriptors []paramSetDescriptor,
+) *FlagManifest {
+	result := &FlagManifest{
+		Name:       flag.Name,
+		Short:      flag.Shorthand,
+		Type:       flag.Value.Type(),
+		Default:    flag.DefValue,
+		Persistent: persistent,
+		Usage:      flag.Usage,
+		Sensitive:  IsSensitive(flag),
+	}
+
+	for _, descriptor := range descriptors {
+		if validator := descriptor.Validators().Get(flag.Name); validator != nil {
+			result.Validator = &ValidatorConstraint{Kind: ConstraintCustom}
+
+			if constrained, ok := validator.(ConstrainedValidator); ok && constrained.GetConstraint() != nil {
+				result.Validator = constrained.GetConstraint()
+			}
+		}
+
+		if enum := descriptor.enumOf(flag.Name); enum != nil {
+			result.Acceptables = enum.Aliases()
+		}
+	}
+
+	return result
+}
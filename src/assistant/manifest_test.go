@@ -0,0 +1,126 @@
+package assistant_test
+
+import (
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant"
+)
+
+var _ = Describe("Manifest", func() {
+	var (
+		container     *assistant.CobraContainer
+		rootCommand   *cobra.Command
+		widgetCommand *cobra.Command
+		paramSet      *assistant.ParamSet[WidgetParameterSet]
+	)
+
+	BeforeEach(func() {
+		rootCommand = &cobra.Command{
+			Use:   "poke",
+			Short: "A brief description of your application",
+		}
+		widgetCommand = &cobra.Command{
+			Use:     "widget",
+			Short:   "Create widget",
+			Aliases: []string{"w"},
+			RunE: func(_ *cobra.Command, _ []string) error {
+				return nil
+			},
+		}
+		container = assistant.NewCobraContainer(rootCommand)
+		container.MustRegisterRootedCommand(widgetCommand)
+		container.MustRegisterRootedCommand(&cobra.Command{
+			Use:   "gadget",
+			Short: "Create gadget",
+		})
+
+		paramSet = assistant.NewParamSet[WidgetParameterSet](widgetCommand)
+		paramSet.BindValidatedIntWithin(
+			assistant.NewFlagInfo("offset is the offset", "o", -1),
+			&paramSet.Native.Offset, 0, 10,
+		)
+		paramSet.BindValidatedString(
+			assistant.NewFlagInfoOnFlagSet("pattern is the pattern", "p", "",
+				widgetCommand.PersistentFlags(),
+			),
+			&paramSet.Native.Pattern,
+			func(_ string, _ *pflag.Flag) error {
+				return nil
+			},
+		)
+
+		outputFormatEnumInfo := assistant.NewEnumInfo(AcceptableOutputFormats)
+		outputFormatEnum := outputFormatEnumInfo.NewValue()
+		paramSet.BindEnum(
+			assistant.NewFlagInfo("format the output format", "f", "xml"),
+			&outputFormatEnum.Source,
+		).AttachEnum("format", outputFormatEnumInfo)
+
+		paramSet.BindBool(
+			assistant.NewFlagInfo("concise ensures that output is compressed", "c", false),
+			&paramSet.Native.Concise,
+		)
+		widgetCommand.MarkFlagsMutuallyExclusive("concise", "format")
+		container.MustRegisterParamSet("widget-ps", paramSet)
+	})
+
+	Context("Manifest", func() {
+		It("🧪 should: describe the command tree", func() {
+			manifest := container.Manifest()
+
+			Expect(manifest.Schema).To(Equal(assistant.ManifestSchema))
+			Expect(manifest.Root.Name).To(Equal("poke"))
+			Expect(manifest.Root.Commands).To(HaveLen(2))
+			Expect(manifest.Root.Commands[0].Name).To(Equal("gadget"))
+
+			widget := manifest.Root.Commands[1]
+			Expect(widget.Path).To(Equal("poke widget"))
+			Expect(widget.Aliases).To(HaveExactElements("w"))
+			Expect(widget.MutuallyExclusive).To(Equal(
+				[][]string{{"concise", "format"}},
+			))
+			Expect(widget.Flags).To(HaveLen(4))
+
+			concise := widget.Flags[0]
+			Expect(concise.Name).To(Equal("concise"))
+			Expect(concise.Validator).To(BeNil())
+
+			format := widget.Flags[1]
+			Expect(format.Acceptables).To(HaveKeyWithValue(
+				"scribble", []string{"scribble", "scribbler", "scr"},
+			))
+
+			offset := widget.Flags[2]
+			Expect(offset.Short).To(Equal("o"))
+			Expect(offset.Type).To(Equal("int"))
+			Expect(offset.Default).To(Equal("-1"))
+			Expect(offset.Persistent).To(BeFalse())
+			Expect(offset.Validator.Kind).To(Equal(assistant.ConstraintWithin))
+			Expect(offset.Validator.Params).To(HaveKeyWithValue("low", 0))
+			Expect(offset.Validator.Params).To(HaveKeyWithValue("high", 10))
+
+			pattern := widget.Flags[3]
+			Expect(pattern.Persistent).To(BeTrue())
+			Expect(pattern.Validator.Kind).To(Equal(assistant.ConstraintCustom))
+		})
+	})
+
+	Context("Marshal", func() {
+		It("🧪 should: create stable json that can be read back", func() {
+			first, err := container.Manifest().Marshal()
+			Expect(err).To(Succeed())
+
+			second, _ := container.Manifest().Marshal()
+			Expect(string(first)).To(Equal(string(second)))
+
+			manifest, err := assistant.UnmarshalManifest(first)
+			Expect(err).To(Succeed())
+			Expect(manifest.Root.Commands[1].Flags[2].Validator.Params).To(
+				HaveKeyWithValue("high", BeNumerically("==", 10)),
+			)
+		})
+	})
+})
@@ -0,0 +1,190 @@
+package assistant
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/internal/third/lo"
+)
+
+// RunE is the signature of the cobra command's RunE function.
+type RunE func(command *cobra.Command, args []string) error
+
+// Middleware decorates the run function of a command. The middleware
+// should invoke next to continue the chain, or return without doing so
+// to intercept the invocation.
+type Middleware func(next RunE) RunE
+
+type middlewareCollection map[string][]Middleware
+
+// UseMiddleware registers middleware that is applied to all commands in the
+// tree. Middleware is applied in the order registered, ie the first
+// registered is the outermost.
+func (container *CobraContainer) UseMiddleware(middleware ...Middleware) {
+	container.middleware = append(container.middleware, middleware...)
+}
+
+// MustUseMiddlewareOn registers middleware that is applied to the command
+// identified by name and all of its descendants. Middleware registered
+// globally with UseMiddleware wraps subtree middleware and the middleware of
+// an ancestor wraps that of its descendants.
+//
+// panics if there is no command registered with the name specified.
+func (container *CobraContainer) MustUseMiddlewareOn(name string, middleware ...Middleware) {
+	if name != container.root.Name() && !container.IsPresent(name) {
+		panic(locale.NewParentCommandNotRegisteredNativeError(name))
+	}
+
+	container.subtrees[name] = append(container.subtrees[name], middleware...)
+}
+
+// ApplyMiddleware decorates the run function of every command in the tree
+// with the registered middleware. A command defined with Run rather than
+// RunE is converted to RunE. It is safe to invoke more than once, eg after
+// registering further middleware, as the chain is always composed from the
+// command's original run function. Does not need to be invoked explicitly
+// when the client uses CobraContainer.Execute.
+func (container *CobraContainer) ApplyMiddleware() {
+	container.applied = true
+	container.decorate(container.root, container.middleware)
+}
+
+func (container *CobraContainer) decorate(command *cobra.Command, inherited []Middleware) {
+	if isLazy(command) {
+		// the middleware is applied to the command created by the factory
+		// when it is materialised.
+		//
+		return
+	}
+
+	chain := inherited
+	registered := lo.Ternary(command == container.root, command, container.commands[command.Name()])
+
+	if own, found := container.subtrees[command.Name()]; found && registered == command {
+		chain = append(append([]Middleware{}, inherited...), own...)
+	}
+
+	if original := container.original(command); original != nil {
+		run := original
+
+		for i := len(chain) - 1; i >= 0; i-- {
+			run = chain[i](run)
+		}
+
+		command.Run = nil
+		command.RunE = run
+	}
+
+	for _, child := range command.Commands() {
+		container.decorate(child, chain)
+	}
+}
+
+// original returns the undecorated run function of the command, nil if
+// the command is not runnable.
+func (container *CobraContainer) original(command *cobra.Command) RunE {
+	if run, found := container.originals[command]; found {
+		return run
+	}
+
+	var run RunE
+
+	switch {
+	case command.RunE != nil:
+		run = command.RunE
+
+	case command.Run != nil:
+		fn := command.Run
+		run = func(c *cobra.Command, args []string) error {
+			fn(c, args)
+			return nil
+		}
+
+	default:
+		return nil
+	}
+
+	container.originals[command] = run
+
+	return run
+}
+
+// RecoverMiddleware creates middleware that recovers from a panic raised
+// during the execution of a command and returns it as a localised error.
+func RecoverMiddleware() Middleware {
+	return func(next RunE) RunE {
+		return func(command *cobra.Command, args []string) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					err = locale.NewCommandPanicError(command.Name(), r)
+				}
+			}()
+
+			return next(command, args)
+		}
+	}
+}
+
+// TimeoutMiddleware creates middleware that imposes a deadline on the
+// execution of a command, via the command's context. The run function
+// should observe the context by calling command.Context().
+func TimeoutMiddleware(timeout time.Duration) Middleware {
+	return func(next RunE) RunE {
+		return func(command *cobra.Command, args []string) error {
+			parent := command.Context()
+			if parent == nil {
+				parent = context.Background()
+			}
+
+			ctx, cancel := context.WithTimeout(parent, timeout)
+			defer cancel()
+
+			command.SetContext(ctx)
+
+			return next(command, args)
+		}
+	}
+}
+
+// LoggingMiddleware creates middleware that records the invocation of a
+// command with the structured logger, including the time taken to run and
+// the error returned, if any.
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	return func(next RunE) RunE {
+		return func(command *cobra.Command, args []string) error {
+			start := time.Now()
+			err := next(command, args)
+			attributes := []any{
+				slog.String("command", command.CommandPath()),
+				slog.Duration("elapsed", time.Since(start)),
+			}
+
+			if err != nil {
+				logger.Error("command failed", append(attributes, slog.Any("error", err))...)
+			} else {
+				logger.Info("command completed", attributes...)
+			}
+
+			return err
+		}
+	}
+}
+
+// DryRunMiddleware creates middleware that intercepts the invocation of a
+// command when isDryRun returns true, invoking preview instead. Typically,
+// isDryRun would return the value of PreviewParameterSet.DryRun.
+func DryRunMiddleware(isDryRun func() bool, preview RunE) Middleware {
+	return func(next RunE) RunE {
+		return func(command *cobra.Command, args []string) error {
+			if isDryRun() {
+				return preview(command, args)
+			}
+
+			return next(command, args)
+		}
+	}
+}
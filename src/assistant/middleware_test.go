@@ -0,0 +1,149 @@
+package assistant_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant"
+	"github.com/snivilised/cobrass/src/assistant/locale"
+)
+
+func tracer(name string, trace *[]string) assistant.Middleware {
+	return func(next assistant.RunE) assistant.RunE {
+		return func(command *cobra.Command, args []string) error {
+			*trace = append(*trace, name)
+
+			return next(command, args)
+		}
+	}
+}
+
+var _ = Describe("Middleware", func() {
+	var (
+		container     *assistant.CobraContainer
+		parentCommand *cobra.Command
+		trace         []string
+	)
+
+	BeforeEach(func() {
+		trace = []string{}
+		container = assistant.NewCobraContainer(&cobra.Command{
+			Use: "root",
+			RunE: func(_ *cobra.Command, _ []string) error {
+				trace = append(trace, "root")
+				return nil
+			},
+		})
+
+		parentCommand = &cobra.Command{
+			Use: "parent",
+			RunE: func(_ *cobra.Command, _ []string) error {
+				trace = append(trace, "parent")
+				return nil
+			},
+		}
+		container.MustRegisterRootedCommand(parentCommand)
+		container.MustRegisterCommand("parent", &cobra.Command{
+			Use: "child",
+			Run: func(_ *cobra.Command, _ []string) {
+				trace = append(trace, "child")
+			},
+		})
+	})
+
+	Context("given: global and subtree middleware", func() {
+		It("🧪 should: apply global middleware outermost", func() {
+			container.UseMiddleware(tracer("global-1", &trace), tracer("global-2", &trace))
+			container.MustUseMiddlewareOn("parent", tracer("parent", &trace))
+			container.MustUseMiddlewareOn("child", tracer("child", &trace))
+			container.Root().SetArgs([]string{"parent", "child"})
+
+			Expect(container.ExecuteE()).To(Succeed())
+			Expect(trace).To(HaveExactElements(
+				"global-1", "global-2", "parent", "child", "child",
+			))
+		})
+
+		It("🧪 should: not apply subtree middleware outside the subtree", func() {
+			container.UseMiddleware(tracer("global", &trace))
+			container.MustUseMiddlewareOn("child", tracer("child", &trace))
+			container.Root().SetArgs([]string{"parent"})
+
+			Expect(container.ExecuteE()).To(Succeed())
+			Expect(trace).To(HaveExactElements("global", "parent"))
+		})
+	})
+
+	Context("given: middleware applied more than once", func() {
+		It("🧪 should: not decorate the command repeatedly", func() {
+			container.UseMiddleware(tracer("global", &trace))
+			container.ApplyMiddleware()
+			container.Root().SetArgs([]string{"parent"})
+
+			Expect(container.ExecuteE()).To(Succeed())
+			Expect(trace).To(HaveExactElements("global", "parent"))
+		})
+	})
+
+	Context("given: subtree middleware for unregistered command", func() {
+		It("🧪 should: panic", func() {
+			Expect(func() {
+				container.MustUseMiddlewareOn("foo", tracer("foo", &trace))
+			}).To(Panic())
+		})
+	})
+
+	Context("RecoverMiddleware", func() {
+		It("🧪 should: return panic as error", func() {
+			container.UseMiddleware(assistant.RecoverMiddleware())
+			parentCommand.RunE = func(_ *cobra.Command, _ []string) error {
+				panic("fake panic")
+			}
+			container.Root().SetArgs([]string{"parent"})
+			container.Root().SilenceErrors = true
+			container.Root().SilenceUsage = true
+
+			err := container.ExecuteE()
+			var query locale.CommandPanicBehaviourQuery
+			Expect(errors.As(err, &query)).To(BeTrue())
+			Expect(query.IsCommandPanic()).To(BeTrue())
+		})
+	})
+
+	Context("TimeoutMiddleware", func() {
+		It("🧪 should: impose deadline on command context", func() {
+			container.UseMiddleware(assistant.TimeoutMiddleware(time.Millisecond))
+			parentCommand.RunE = func(cmd *cobra.Command, _ []string) error {
+				<-cmd.Context().Done()
+
+				return cmd.Context().Err()
+			}
+			container.Root().SetArgs([]string{"parent"})
+			container.Root().SilenceErrors = true
+			container.Root().SilenceUsage = true
+
+			Expect(container.ExecuteE()).To(MatchError(context.DeadlineExceeded))
+		})
+	})
+
+	Context("DryRunMiddleware", func() {
+		It("🧪 should: invoke preview instead of command", func() {
+			container.UseMiddleware(assistant.DryRunMiddleware(
+				func() bool { return true },
+				func(_ *cobra.Command, _ []string) error {
+					trace = append(trace, "preview")
+					return nil
+				},
+			))
+			container.Root().SetArgs([]string{"parent"})
+
+			Expect(container.ExecuteE()).To(Succeed())
+			Expect(trace).To(HaveExactElements("preview"))
+		})
+	})
+})
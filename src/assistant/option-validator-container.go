@@ -1,7 +1,10 @@
 package assistant
 
 import (
+	"slices"
+
 	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/internal/third/lo"
 )
 
 type ValidatorCollection map[string]OptionValidator
@@ -64,10 +67,27 @@ func (container ValidatorContainer) Get(flag string) OptionValidator {
 // in turn, invokes the client defined validator function.
 func (container ValidatorContainer) run() error {
 	for _, validator := range container.validators {
-		if err := validator.Validate(); err != nil {
+		if err := validate(validator); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// collect invokes all validators registered, in flag name order, and returns
+// all the errors that occurred.
+func (container ValidatorContainer) collect() []error {
+	flags := lo.Keys(container.validators)
+	slices.Sort(flags)
+
+	errs := []error{}
+
+	for _, flag := range flags {
+		if err := validate(container.validators[flag]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,152 @@
+package assistant
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+)
+
+// The following functions create the validator functions from which the
+// predefined validation helpers (eg BindValidatedIntWithin) are built, for
+// use where a validator function is required, eg for the values of a map
+// flag (see BindMap). Unlike the helpers, they validate the value regardless
+// of whether the flag has been provided by the user.
+
+// whenChanged adapts the validator, so that, as per the predefined
+// validation helpers, it only validates the value of a flag that has been
+// provided by the user.
+func whenChanged[T any](validator ValueValidatorFn[T]) ValueValidatorFn[T] {
+	return func(value T, flag *pflag.Flag) error {
+		if !flag.Changed {
+			return nil
+		}
+
+		return validator(value, flag)
+	}
+}
+
+// WithinValidator fails validation if the value does not lie within 'low'
+// and 'high' (inclusive).
+func WithinValidator[T cmp.Ordered](low, high T) ValueValidatorFn[T] {
+	return func(value T, flag *pflag.Flag) error {
+		if value >= low && value <= high {
+			return nil
+		}
+
+		return locale.NewWithinOptValidationError(flag.Name, value, low, high)
+	}
+}
+
+// NotWithinValidator fails validation if the value lies within 'low' and
+// 'high' (inclusive).
+func NotWithinValidator[T cmp.Ordered](low, high T) ValueValidatorFn[T] {
+	return func(value T, flag *pflag.Flag) error {
+		if value < low || value > high {
+			return nil
+		}
+
+		return locale.NewNotWithinOptValidationError(flag.Name, value, low, high)
+	}
+}
+
+// GreaterThanValidator fails validation if the value is not greater than
+// 'threshold'.
+func GreaterThanValidator[T cmp.Ordered](threshold T) ValueValidatorFn[T] {
+	return func(value T, flag *pflag.Flag) error {
+		if value > threshold {
+			return nil
+		}
+
+		return locale.NewGreaterThanOptValidationError(flag.Name, value, threshold)
+	}
+}
+
+// AtLeastValidator fails validation if the value is less than 'threshold'.
+func AtLeastValidator[T cmp.Ordered](threshold T) ValueValidatorFn[T] {
+	return func(value T, flag *pflag.Flag) error {
+		if value >= threshold {
+			return nil
+		}
+
+		return locale.NewAtLeastOptValidationError(flag.Name, value, threshold)
+	}
+}
+
+// LessThanValidator fails validation if the value is not less than
+// 'threshold'.
+func LessThanValidator[T cmp.Ordered](threshold T) ValueValidatorFn[T] {
+	return func(value T, flag *pflag.Flag) error {
+		if value < threshold {
+			return nil
+		}
+
+		return locale.NewLessThanOptValidationError(flag.Name, value, threshold)
+	}
+}
+
+// AtMostValidator fails validation if the value is greater than 'threshold'.
+func AtMostValidator[T cmp.Ordered](threshold T) ValueValidatorFn[T] {
+	return func(value T, flag *pflag.Flag) error {
+		if value <= threshold {
+			return nil
+		}
+
+		return locale.NewAtMostOptValidationError(flag.Name, value, threshold)
+	}
+}
+
+// ContainsValidator fails validation if the value is not a member of the
+// 'collection'.
+func ContainsValidator[T comparable](collection []T) ValueValidatorFn[T] {
+	return func(value T, flag *pflag.Flag) error {
+		if slices.Contains(collection, value) {
+			return nil
+		}
+
+		return locale.NewContainsOptValidationError(flag.Name, value, collection)
+	}
+}
+
+// NotContainsValidator fails validation if the value is a member of the
+// 'collection'.
+func NotContainsValidator[T comparable](collection []T) ValueValidatorFn[T] {
+	return func(value T, flag *pflag.Flag) error {
+		if !slices.Contains(collection, value) {
+			return nil
+		}
+
+		return locale.NewNotContainsOptValidationError(flag.Name, value, collection)
+	}
+}
+
+// MatchValidator fails validation if the value does not match the regular
+// expression denoted by 'pattern'.
+func MatchValidator(pattern string) ValueValidatorFn[string] {
+	rx := regexp.MustCompile(pattern)
+
+	return func(value string, flag *pflag.Flag) error {
+		if rx.MatchString(value) {
+			return nil
+		}
+
+		return locale.NewMatchOptValidationError(flag.Name, value, pattern)
+	}
+}
+
+// NotMatchValidator fails validation if the value matches the regular
+// expression denoted by 'pattern'.
+func NotMatchValidator(pattern string) ValueValidatorFn[string] {
+	rx := regexp.MustCompile(pattern)
+
+	return func(value string, flag *pflag.Flag) error {
+		if !rx.MatchString(value) {
+			return nil
+		}
+
+		return locale.NewNotMatchOptValidationError(flag.Name, value, pattern)
+	}
+}
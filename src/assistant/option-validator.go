@@ -2,6 +2,8 @@ package assistant
 
 import (
 	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
 )
 
 // OptionValidator wraps the user defined option validator function.
@@ -12,12 +14,110 @@ type OptionValidator interface {
 	GetFlag() *pflag.Flag
 }
 
+// ConstraintKind identifies the predefined validation helper that was
+// used to bind a flag, eg 'within' for BindValidatedIntWithin.
+type ConstraintKind string
+
+const (
+	// ConstraintWithin (see BindValidated<Type>Within)
+	ConstraintWithin ConstraintKind = locale.ConstraintWithin
+
+	// ConstraintNotWithin (see BindValidated<Type>NotWithin)
+	ConstraintNotWithin ConstraintKind = locale.ConstraintNotWithin
+
+	// ConstraintContains (see BindValidatedContains<Type>)
+	ConstraintContains ConstraintKind = locale.ConstraintContains
+
+	// ConstraintNotContains (see BindValidatedNotContains<Type>)
+	ConstraintNotContains ConstraintKind = locale.ConstraintNotContains
+
+	// ConstraintMatch (see BindValidated<Type>IsMatch)
+	ConstraintMatch ConstraintKind = locale.ConstraintMatch
+
+	// ConstraintNotMatch (see BindValidated<Type>IsNotMatch)
+	ConstraintNotMatch ConstraintKind = locale.ConstraintNotMatch
+
+	// ConstraintGreaterThan (see BindValidated<Type>GreaterThan)
+	ConstraintGreaterThan ConstraintKind = locale.ConstraintGreaterThan
+
+	// ConstraintAtLeast (see BindValidated<Type>AtLeast)
+	ConstraintAtLeast ConstraintKind = locale.ConstraintAtLeast
+
+	// ConstraintLessThan (see BindValidated<Type>LessThan)
+	ConstraintLessThan ConstraintKind = locale.ConstraintLessThan
+
+	// ConstraintAtMost (see BindValidated<Type>AtMost)
+	ConstraintAtMost ConstraintKind = locale.ConstraintAtMost
+
+	// ConstraintCustom denotes a validator defined by a client function
+	ConstraintCustom ConstraintKind = "custom"
+)
+
+// ValidatorConstraint describes the predefined validation applied to a flag,
+// so that it can be reported without having to invoke the validator, eg in
+// a manifest. Validators defined by a client function do not have a
+// constraint, since their behaviour is opaque.
+type ValidatorConstraint struct {
+	// Kind identifies the validation helper
+	//
+	Kind ConstraintKind `json:"kind"`
+
+	// Params contains the arguments passed into the helper, keyed by the
+	// name of the argument, eg 'low' and 'high' for a 'within' constraint.
+	//
+	Params map[string]any `json:"params,omitempty"`
+}
+
+// ConstrainedValidator is implemented by option validators that can
+// describe the constraint they enforce.
+type ConstrainedValidator interface {
+	GetConstraint() *ValidatorConstraint
+}
+
+func rangeConstraint(kind ConstraintKind, low, high any) *ValidatorConstraint {
+	return &ValidatorConstraint{
+		Kind: kind,
+		Params: map[string]any{
+			"low":  low,
+			"high": high,
+		},
+	}
+}
+
+func memberConstraint(kind ConstraintKind, collection any) *ValidatorConstraint {
+	return &ValidatorConstraint{
+		Kind: kind,
+		Params: map[string]any{
+			"collection": collection,
+		},
+	}
+}
+
+func matchConstraint(kind ConstraintKind, pattern string) *ValidatorConstraint {
+	return &ValidatorConstraint{
+		Kind: kind,
+		Params: map[string]any{
+			"pattern": pattern,
+		},
+	}
+}
+
+func thresholdConstraint(kind ConstraintKind, threshold any) *ValidatorConstraint {
+	return &ValidatorConstraint{
+		Kind: kind,
+		Params: map[string]any{
+			"threshold": threshold,
+		},
+	}
+}
+
 // Needed because its not possible to create a type safe heterogeneous collection
 // of objects that would be required for the ValidatorContainer.
 type GenericOptionValidatorWrapper[T any] struct {
-	Fn    func(T, *pflag.Flag) error
-	Value *T
-	Flag  *pflag.Flag
+	Fn         func(T, *pflag.Flag) error
+	Value      *T
+	Flag       *pflag.Flag
+	Constraint *ValidatorConstraint
 }
 
 func (validator GenericOptionValidatorWrapper[T]) Validate() error {
@@ -32,6 +132,12 @@ func (validator GenericOptionValidatorWrapper[T]) GetFlag() *pflag.Flag {
 	return validator.Flag
 }
 
+// GetConstraint returns the constraint enforced by the validator, nil if the
+// validator was defined by a client function.
+func (validator GenericOptionValidatorWrapper[T]) GetConstraint() *ValidatorConstraint {
+	return validator.Constraint
+}
+
 // CrossFieldValidator is a client function that is the callback passed into
 // ParamSet.CrossValidate. Should be done after all parsed values have been bound
 // and individually validated.
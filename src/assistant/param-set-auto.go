@@ -17,6 +17,8 @@ func (params *ParamSet[N]) BindBool(info *FlagInfo, to *bool) *ParamSet[N] {
 		flagSet.BoolVarP(to, info.FlagName(), info.Short, info.Default.(bool), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -30,6 +32,8 @@ func (params *ParamSet[N]) BindBoolSlice(info *FlagInfo, to *[]bool) *ParamSet[N
 		flagSet.BoolSliceVarP(to, info.FlagName(), info.Short, info.Default.([]bool), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -43,6 +47,8 @@ func (params *ParamSet[N]) BindDuration(info *FlagInfo, to *time.Duration) *Para
 		flagSet.DurationVarP(to, info.FlagName(), info.Short, info.Default.(time.Duration), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -72,6 +78,8 @@ func (params *ParamSet[N]) BindDurationSlice(info *FlagInfo, to *[]time.Duration
 		flagSet.DurationSliceVarP(to, info.FlagName(), info.Short, info.Default.([]time.Duration), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -113,6 +121,8 @@ func (params *ParamSet[N]) BindEnum(info *FlagInfo, to *string) *ParamSet[N] {
 		flagSet.StringVarP(to, info.FlagName(), info.Short, info.Default.(string), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -147,6 +157,8 @@ func (params *ParamSet[N]) BindFloat32(info *FlagInfo, to *float32) *ParamSet[N]
 		flagSet.Float32VarP(to, info.FlagName(), info.Short, info.Default.(float32), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -176,6 +188,8 @@ func (params *ParamSet[N]) BindFloat32Slice(info *FlagInfo, to *[]float32) *Para
 		flagSet.Float32SliceVarP(to, info.FlagName(), info.Short, info.Default.([]float32), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -205,6 +219,8 @@ func (params *ParamSet[N]) BindFloat64(info *FlagInfo, to *float64) *ParamSet[N]
 		flagSet.Float64VarP(to, info.FlagName(), info.Short, info.Default.(float64), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -234,6 +250,8 @@ func (params *ParamSet[N]) BindFloat64Slice(info *FlagInfo, to *[]float64) *Para
 		flagSet.Float64SliceVarP(to, info.FlagName(), info.Short, info.Default.([]float64), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -263,6 +281,8 @@ func (params *ParamSet[N]) BindInt(info *FlagInfo, to *int) *ParamSet[N] {
 		flagSet.IntVarP(to, info.FlagName(), info.Short, info.Default.(int), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -292,6 +312,8 @@ func (params *ParamSet[N]) BindIntSlice(info *FlagInfo, to *[]int) *ParamSet[N]
 		flagSet.IntSliceVarP(to, info.FlagName(), info.Short, info.Default.([]int), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -321,6 +343,8 @@ func (params *ParamSet[N]) BindInt16(info *FlagInfo, to *int16) *ParamSet[N] {
 		flagSet.Int16VarP(to, info.FlagName(), info.Short, info.Default.(int16), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -350,6 +374,8 @@ func (params *ParamSet[N]) BindInt32(info *FlagInfo, to *int32) *ParamSet[N] {
 		flagSet.Int32VarP(to, info.FlagName(), info.Short, info.Default.(int32), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -379,6 +405,8 @@ func (params *ParamSet[N]) BindInt32Slice(info *FlagInfo, to *[]int32) *ParamSet
 		flagSet.Int32SliceVarP(to, info.FlagName(), info.Short, info.Default.([]int32), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -408,6 +436,8 @@ func (params *ParamSet[N]) BindInt64(info *FlagInfo, to *int64) *ParamSet[N] {
 		flagSet.Int64VarP(to, info.FlagName(), info.Short, info.Default.(int64), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -437,6 +467,8 @@ func (params *ParamSet[N]) BindInt64Slice(info *FlagInfo, to *[]int64) *ParamSet
 		flagSet.Int64SliceVarP(to, info.FlagName(), info.Short, info.Default.([]int64), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -466,6 +498,8 @@ func (params *ParamSet[N]) BindInt8(info *FlagInfo, to *int8) *ParamSet[N] {
 		flagSet.Int8VarP(to, info.FlagName(), info.Short, info.Default.(int8), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -495,6 +529,8 @@ func (params *ParamSet[N]) BindIPMask(info *FlagInfo, to *net.IPMask) *ParamSet[
 		flagSet.IPMaskVarP(to, info.FlagName(), info.Short, info.Default.(net.IPMask), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -524,6 +560,8 @@ func (params *ParamSet[N]) BindIPNet(info *FlagInfo, to *net.IPNet) *ParamSet[N]
 		flagSet.IPNetVarP(to, info.FlagName(), info.Short, info.Default.(net.IPNet), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -553,6 +591,8 @@ func (params *ParamSet[N]) BindString(info *FlagInfo, to *string) *ParamSet[N] {
 		flagSet.StringVarP(to, info.FlagName(), info.Short, info.Default.(string), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -582,6 +622,8 @@ func (params *ParamSet[N]) BindStringSlice(info *FlagInfo, to *[]string) *ParamS
 		flagSet.StringSliceVarP(to, info.FlagName(), info.Short, info.Default.([]string), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -611,6 +653,8 @@ func (params *ParamSet[N]) BindUint16(info *FlagInfo, to *uint16) *ParamSet[N] {
 		flagSet.Uint16VarP(to, info.FlagName(), info.Short, info.Default.(uint16), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -640,6 +684,8 @@ func (params *ParamSet[N]) BindUint32(info *FlagInfo, to *uint32) *ParamSet[N] {
 		flagSet.Uint32VarP(to, info.FlagName(), info.Short, info.Default.(uint32), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -669,6 +715,8 @@ func (params *ParamSet[N]) BindUint64(info *FlagInfo, to *uint64) *ParamSet[N] {
 		flagSet.Uint64VarP(to, info.FlagName(), info.Short, info.Default.(uint64), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -698,6 +746,8 @@ func (params *ParamSet[N]) BindUint8(info *FlagInfo, to *uint8) *ParamSet[N] {
 		flagSet.Uint8VarP(to, info.FlagName(), info.Short, info.Default.(uint8), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -727,6 +777,8 @@ func (params *ParamSet[N]) BindUint(info *FlagInfo, to *uint) *ParamSet[N] {
 		flagSet.UintVarP(to, info.FlagName(), info.Short, info.Default.(uint), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
@@ -756,6 +808,8 @@ func (params *ParamSet[N]) BindUintSlice(info *FlagInfo, to *[]uint) *ParamSet[N
 		flagSet.UintSliceVarP(to, info.FlagName(), info.Short, info.Default.([]uint), info.Usage)
 	}
 
+	markSensitive(flagSet, info)
+
 	return params
 }
 
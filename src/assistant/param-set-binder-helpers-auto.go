@@ -1,14 +1,7 @@
-//nolint:gocritic // regexp.MustCompile: solution unknown
 package assistant
 
 import (
-	"regexp"
 	"time"
-
-	"github.com/snivilised/cobrass/src/assistant/locale"
-	"github.com/snivilised/cobrass/src/internal/third/lo"
-
-	"github.com/spf13/pflag"
 )
 
 // ----> auto generated(Build-Predefined/gen-help)
@@ -20,18 +13,10 @@ func (params *ParamSet[N]) BindValidatedDurationWithin(info *FlagInfo, to *time.
 	params.BindDuration(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[time.Duration]{
-		Fn: func(value time.Duration, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= low && value <= high {
-				return nil
-			}
-
-			return locale.NewWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(WithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -45,18 +30,10 @@ func (params *ParamSet[N]) BindValidatedDurationNotWithin(info *FlagInfo, to *ti
 	params.BindDuration(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[time.Duration]{
-		Fn: func(value time.Duration, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(value >= low && value <= high) {
-				return nil
-			}
-
-			return locale.NewNotWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotWithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintNotWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -70,18 +47,10 @@ func (params *ParamSet[N]) BindValidatedContainsDuration(info *FlagInfo, to *tim
 	params.BindDuration(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[time.Duration]{
-		Fn: func(value time.Duration, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if lo.IndexOf(collection, value) >= 0 {
-				return nil
-			}
-
-			return locale.NewContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(ContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -95,18 +64,10 @@ func (params *ParamSet[N]) BindValidatedNotContainsDuration(info *FlagInfo, to *
 	params.BindDuration(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[time.Duration]{
-		Fn: func(value time.Duration, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(lo.IndexOf(collection, value) >= 0) {
-				return nil
-			}
-
-			return locale.NewNotContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintNotContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -120,18 +81,10 @@ func (params *ParamSet[N]) BindValidatedDurationGreaterThan(info *FlagInfo, to *
 	params.BindDuration(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[time.Duration]{
-		Fn: func(value time.Duration, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value > threshold {
-				return nil
-			}
-
-			return locale.NewGreaterThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(GreaterThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintGreaterThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -145,18 +98,10 @@ func (params *ParamSet[N]) BindValidatedDurationAtLeast(info *FlagInfo, to *time
 	params.BindDuration(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[time.Duration]{
-		Fn: func(value time.Duration, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= threshold {
-				return nil
-			}
-
-			return locale.NewAtLeastOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtLeastValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtLeast, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -170,18 +115,10 @@ func (params *ParamSet[N]) BindValidatedDurationLessThan(info *FlagInfo, to *tim
 	params.BindDuration(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[time.Duration]{
-		Fn: func(value time.Duration, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value < threshold {
-				return nil
-			}
-
-			return locale.NewLessThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(LessThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintLessThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -195,18 +132,10 @@ func (params *ParamSet[N]) BindValidatedDurationAtMost(info *FlagInfo, to *time.
 	params.BindDuration(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[time.Duration]{
-		Fn: func(value time.Duration, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value <= threshold {
-				return nil
-			}
-
-			return locale.NewAtMostOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtMostValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtMost, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -220,18 +149,10 @@ func (params *ParamSet[N]) BindValidatedContainsEnum(info *FlagInfo, to *string,
 	params.BindEnum(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[string]{
-		Fn: func(value string, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if lo.IndexOf(collection, value) >= 0 {
-				return nil
-			}
-
-			return locale.NewContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(ContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -245,18 +166,10 @@ func (params *ParamSet[N]) BindValidatedNotContainsEnum(info *FlagInfo, to *stri
 	params.BindEnum(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[string]{
-		Fn: func(value string, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(lo.IndexOf(collection, value) >= 0) {
-				return nil
-			}
-
-			return locale.NewNotContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintNotContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -270,18 +183,10 @@ func (params *ParamSet[N]) BindValidatedFloat32Within(info *FlagInfo, to *float3
 	params.BindFloat32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float32]{
-		Fn: func(value float32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= low && value <= high {
-				return nil
-			}
-
-			return locale.NewWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(WithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -295,18 +200,10 @@ func (params *ParamSet[N]) BindValidatedFloat32NotWithin(info *FlagInfo, to *flo
 	params.BindFloat32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float32]{
-		Fn: func(value float32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(value >= low && value <= high) {
-				return nil
-			}
-
-			return locale.NewNotWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotWithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintNotWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -320,18 +217,10 @@ func (params *ParamSet[N]) BindValidatedContainsFloat32(info *FlagInfo, to *floa
 	params.BindFloat32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float32]{
-		Fn: func(value float32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if lo.IndexOf(collection, value) >= 0 {
-				return nil
-			}
-
-			return locale.NewContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(ContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -345,18 +234,10 @@ func (params *ParamSet[N]) BindValidatedNotContainsFloat32(info *FlagInfo, to *f
 	params.BindFloat32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float32]{
-		Fn: func(value float32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(lo.IndexOf(collection, value) >= 0) {
-				return nil
-			}
-
-			return locale.NewNotContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintNotContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -370,18 +251,10 @@ func (params *ParamSet[N]) BindValidatedFloat32GreaterThan(info *FlagInfo, to *f
 	params.BindFloat32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float32]{
-		Fn: func(value float32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value > threshold {
-				return nil
-			}
-
-			return locale.NewGreaterThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(GreaterThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintGreaterThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -395,18 +268,10 @@ func (params *ParamSet[N]) BindValidatedFloat32AtLeast(info *FlagInfo, to *float
 	params.BindFloat32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float32]{
-		Fn: func(value float32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= threshold {
-				return nil
-			}
-
-			return locale.NewAtLeastOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtLeastValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtLeast, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -420,18 +285,10 @@ func (params *ParamSet[N]) BindValidatedFloat32LessThan(info *FlagInfo, to *floa
 	params.BindFloat32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float32]{
-		Fn: func(value float32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value < threshold {
-				return nil
-			}
-
-			return locale.NewLessThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(LessThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintLessThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -445,18 +302,10 @@ func (params *ParamSet[N]) BindValidatedFloat32AtMost(info *FlagInfo, to *float3
 	params.BindFloat32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float32]{
-		Fn: func(value float32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value <= threshold {
-				return nil
-			}
-
-			return locale.NewAtMostOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtMostValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtMost, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -470,18 +319,10 @@ func (params *ParamSet[N]) BindValidatedFloat64Within(info *FlagInfo, to *float6
 	params.BindFloat64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float64]{
-		Fn: func(value float64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= low && value <= high {
-				return nil
-			}
-
-			return locale.NewWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(WithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -495,18 +336,10 @@ func (params *ParamSet[N]) BindValidatedFloat64NotWithin(info *FlagInfo, to *flo
 	params.BindFloat64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float64]{
-		Fn: func(value float64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(value >= low && value <= high) {
-				return nil
-			}
-
-			return locale.NewNotWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotWithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintNotWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -520,18 +353,10 @@ func (params *ParamSet[N]) BindValidatedContainsFloat64(info *FlagInfo, to *floa
 	params.BindFloat64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float64]{
-		Fn: func(value float64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if lo.IndexOf(collection, value) >= 0 {
-				return nil
-			}
-
-			return locale.NewContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(ContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -545,18 +370,10 @@ func (params *ParamSet[N]) BindValidatedNotContainsFloat64(info *FlagInfo, to *f
 	params.BindFloat64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float64]{
-		Fn: func(value float64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(lo.IndexOf(collection, value) >= 0) {
-				return nil
-			}
-
-			return locale.NewNotContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintNotContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -570,18 +387,10 @@ func (params *ParamSet[N]) BindValidatedFloat64GreaterThan(info *FlagInfo, to *f
 	params.BindFloat64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float64]{
-		Fn: func(value float64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value > threshold {
-				return nil
-			}
-
-			return locale.NewGreaterThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(GreaterThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintGreaterThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -595,18 +404,10 @@ func (params *ParamSet[N]) BindValidatedFloat64AtLeast(info *FlagInfo, to *float
 	params.BindFloat64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float64]{
-		Fn: func(value float64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= threshold {
-				return nil
-			}
-
-			return locale.NewAtLeastOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtLeastValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtLeast, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -620,18 +421,10 @@ func (params *ParamSet[N]) BindValidatedFloat64LessThan(info *FlagInfo, to *floa
 	params.BindFloat64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float64]{
-		Fn: func(value float64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value < threshold {
-				return nil
-			}
-
-			return locale.NewLessThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(LessThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintLessThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -645,18 +438,10 @@ func (params *ParamSet[N]) BindValidatedFloat64AtMost(info *FlagInfo, to *float6
 	params.BindFloat64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[float64]{
-		Fn: func(value float64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value <= threshold {
-				return nil
-			}
-
-			return locale.NewAtMostOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtMostValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtMost, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -670,18 +455,10 @@ func (params *ParamSet[N]) BindValidatedIntWithin(info *FlagInfo, to *int, low,
 	params.BindInt(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int]{
-		Fn: func(value int, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= low && value <= high {
-				return nil
-			}
-
-			return locale.NewWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(WithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -695,18 +472,10 @@ func (params *ParamSet[N]) BindValidatedIntNotWithin(info *FlagInfo, to *int, lo
 	params.BindInt(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int]{
-		Fn: func(value int, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(value >= low && value <= high) {
-				return nil
-			}
-
-			return locale.NewNotWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotWithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintNotWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -720,18 +489,10 @@ func (params *ParamSet[N]) BindValidatedContainsInt(info *FlagInfo, to *int, col
 	params.BindInt(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int]{
-		Fn: func(value int, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if lo.IndexOf(collection, value) >= 0 {
-				return nil
-			}
-
-			return locale.NewContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(ContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -745,18 +506,10 @@ func (params *ParamSet[N]) BindValidatedNotContainsInt(info *FlagInfo, to *int,
 	params.BindInt(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int]{
-		Fn: func(value int, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(lo.IndexOf(collection, value) >= 0) {
-				return nil
-			}
-
-			return locale.NewNotContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintNotContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -770,18 +523,10 @@ func (params *ParamSet[N]) BindValidatedIntGreaterThan(info *FlagInfo, to *int,
 	params.BindInt(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int]{
-		Fn: func(value int, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value > threshold {
-				return nil
-			}
-
-			return locale.NewGreaterThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(GreaterThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintGreaterThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -795,18 +540,10 @@ func (params *ParamSet[N]) BindValidatedIntAtLeast(info *FlagInfo, to *int, thre
 	params.BindInt(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int]{
-		Fn: func(value int, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= threshold {
-				return nil
-			}
-
-			return locale.NewAtLeastOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtLeastValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtLeast, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -820,18 +557,10 @@ func (params *ParamSet[N]) BindValidatedIntLessThan(info *FlagInfo, to *int, thr
 	params.BindInt(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int]{
-		Fn: func(value int, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value < threshold {
-				return nil
-			}
-
-			return locale.NewLessThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(LessThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintLessThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -845,18 +574,10 @@ func (params *ParamSet[N]) BindValidatedIntAtMost(info *FlagInfo, to *int, thres
 	params.BindInt(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int]{
-		Fn: func(value int, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value <= threshold {
-				return nil
-			}
-
-			return locale.NewAtMostOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtMostValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtMost, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -870,18 +591,10 @@ func (params *ParamSet[N]) BindValidatedInt16Within(info *FlagInfo, to *int16, l
 	params.BindInt16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int16]{
-		Fn: func(value int16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= low && value <= high {
-				return nil
-			}
-
-			return locale.NewWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(WithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -895,18 +608,10 @@ func (params *ParamSet[N]) BindValidatedInt16NotWithin(info *FlagInfo, to *int16
 	params.BindInt16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int16]{
-		Fn: func(value int16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(value >= low && value <= high) {
-				return nil
-			}
-
-			return locale.NewNotWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotWithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintNotWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -920,18 +625,10 @@ func (params *ParamSet[N]) BindValidatedContainsInt16(info *FlagInfo, to *int16,
 	params.BindInt16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int16]{
-		Fn: func(value int16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if lo.IndexOf(collection, value) >= 0 {
-				return nil
-			}
-
-			return locale.NewContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(ContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -945,18 +642,10 @@ func (params *ParamSet[N]) BindValidatedNotContainsInt16(info *FlagInfo, to *int
 	params.BindInt16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int16]{
-		Fn: func(value int16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(lo.IndexOf(collection, value) >= 0) {
-				return nil
-			}
-
-			return locale.NewNotContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintNotContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -970,18 +659,10 @@ func (params *ParamSet[N]) BindValidatedInt16GreaterThan(info *FlagInfo, to *int
 	params.BindInt16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int16]{
-		Fn: func(value int16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value > threshold {
-				return nil
-			}
-
-			return locale.NewGreaterThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(GreaterThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintGreaterThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -995,18 +676,10 @@ func (params *ParamSet[N]) BindValidatedInt16AtLeast(info *FlagInfo, to *int16,
 	params.BindInt16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int16]{
-		Fn: func(value int16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= threshold {
-				return nil
-			}
-
-			return locale.NewAtLeastOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtLeastValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtLeast, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1020,18 +693,10 @@ func (params *ParamSet[N]) BindValidatedInt16LessThan(info *FlagInfo, to *int16,
 	params.BindInt16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int16]{
-		Fn: func(value int16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value < threshold {
-				return nil
-			}
-
-			return locale.NewLessThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(LessThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintLessThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1045,18 +710,10 @@ func (params *ParamSet[N]) BindValidatedInt16AtMost(info *FlagInfo, to *int16, t
 	params.BindInt16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int16]{
-		Fn: func(value int16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value <= threshold {
-				return nil
-			}
-
-			return locale.NewAtMostOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtMostValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtMost, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1070,18 +727,10 @@ func (params *ParamSet[N]) BindValidatedInt32Within(info *FlagInfo, to *int32, l
 	params.BindInt32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int32]{
-		Fn: func(value int32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= low && value <= high {
-				return nil
-			}
-
-			return locale.NewWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(WithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1095,18 +744,10 @@ func (params *ParamSet[N]) BindValidatedInt32NotWithin(info *FlagInfo, to *int32
 	params.BindInt32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int32]{
-		Fn: func(value int32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(value >= low && value <= high) {
-				return nil
-			}
-
-			return locale.NewNotWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotWithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintNotWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1120,18 +761,10 @@ func (params *ParamSet[N]) BindValidatedContainsInt32(info *FlagInfo, to *int32,
 	params.BindInt32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int32]{
-		Fn: func(value int32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if lo.IndexOf(collection, value) >= 0 {
-				return nil
-			}
-
-			return locale.NewContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(ContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1145,18 +778,10 @@ func (params *ParamSet[N]) BindValidatedNotContainsInt32(info *FlagInfo, to *int
 	params.BindInt32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int32]{
-		Fn: func(value int32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(lo.IndexOf(collection, value) >= 0) {
-				return nil
-			}
-
-			return locale.NewNotContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintNotContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1170,18 +795,10 @@ func (params *ParamSet[N]) BindValidatedInt32GreaterThan(info *FlagInfo, to *int
 	params.BindInt32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int32]{
-		Fn: func(value int32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value > threshold {
-				return nil
-			}
-
-			return locale.NewGreaterThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(GreaterThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintGreaterThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1195,18 +812,10 @@ func (params *ParamSet[N]) BindValidatedInt32AtLeast(info *FlagInfo, to *int32,
 	params.BindInt32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int32]{
-		Fn: func(value int32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= threshold {
-				return nil
-			}
-
-			return locale.NewAtLeastOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtLeastValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtLeast, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1220,18 +829,10 @@ func (params *ParamSet[N]) BindValidatedInt32LessThan(info *FlagInfo, to *int32,
 	params.BindInt32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int32]{
-		Fn: func(value int32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value < threshold {
-				return nil
-			}
-
-			return locale.NewLessThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(LessThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintLessThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1245,18 +846,10 @@ func (params *ParamSet[N]) BindValidatedInt32AtMost(info *FlagInfo, to *int32, t
 	params.BindInt32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int32]{
-		Fn: func(value int32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value <= threshold {
-				return nil
-			}
-
-			return locale.NewAtMostOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtMostValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtMost, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1270,18 +863,10 @@ func (params *ParamSet[N]) BindValidatedInt64Within(info *FlagInfo, to *int64, l
 	params.BindInt64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int64]{
-		Fn: func(value int64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= low && value <= high {
-				return nil
-			}
-
-			return locale.NewWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(WithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1295,18 +880,10 @@ func (params *ParamSet[N]) BindValidatedInt64NotWithin(info *FlagInfo, to *int64
 	params.BindInt64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int64]{
-		Fn: func(value int64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(value >= low && value <= high) {
-				return nil
-			}
-
-			return locale.NewNotWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotWithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintNotWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1320,18 +897,10 @@ func (params *ParamSet[N]) BindValidatedContainsInt64(info *FlagInfo, to *int64,
 	params.BindInt64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int64]{
-		Fn: func(value int64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if lo.IndexOf(collection, value) >= 0 {
-				return nil
-			}
-
-			return locale.NewContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(ContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1345,18 +914,10 @@ func (params *ParamSet[N]) BindValidatedNotContainsInt64(info *FlagInfo, to *int
 	params.BindInt64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int64]{
-		Fn: func(value int64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(lo.IndexOf(collection, value) >= 0) {
-				return nil
-			}
-
-			return locale.NewNotContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintNotContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1370,18 +931,10 @@ func (params *ParamSet[N]) BindValidatedInt64GreaterThan(info *FlagInfo, to *int
 	params.BindInt64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int64]{
-		Fn: func(value int64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value > threshold {
-				return nil
-			}
-
-			return locale.NewGreaterThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(GreaterThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintGreaterThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1395,18 +948,10 @@ func (params *ParamSet[N]) BindValidatedInt64AtLeast(info *FlagInfo, to *int64,
 	params.BindInt64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int64]{
-		Fn: func(value int64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= threshold {
-				return nil
-			}
-
-			return locale.NewAtLeastOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtLeastValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtLeast, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1420,18 +965,10 @@ func (params *ParamSet[N]) BindValidatedInt64LessThan(info *FlagInfo, to *int64,
 	params.BindInt64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int64]{
-		Fn: func(value int64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value < threshold {
-				return nil
-			}
-
-			return locale.NewLessThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(LessThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintLessThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1445,18 +982,10 @@ func (params *ParamSet[N]) BindValidatedInt64AtMost(info *FlagInfo, to *int64, t
 	params.BindInt64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int64]{
-		Fn: func(value int64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value <= threshold {
-				return nil
-			}
-
-			return locale.NewAtMostOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtMostValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtMost, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1470,18 +999,10 @@ func (params *ParamSet[N]) BindValidatedInt8Within(info *FlagInfo, to *int8, low
 	params.BindInt8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int8]{
-		Fn: func(value int8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= low && value <= high {
-				return nil
-			}
-
-			return locale.NewWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(WithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1495,18 +1016,10 @@ func (params *ParamSet[N]) BindValidatedInt8NotWithin(info *FlagInfo, to *int8,
 	params.BindInt8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int8]{
-		Fn: func(value int8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(value >= low && value <= high) {
-				return nil
-			}
-
-			return locale.NewNotWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotWithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintNotWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1520,18 +1033,10 @@ func (params *ParamSet[N]) BindValidatedContainsInt8(info *FlagInfo, to *int8, c
 	params.BindInt8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int8]{
-		Fn: func(value int8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if lo.IndexOf(collection, value) >= 0 {
-				return nil
-			}
-
-			return locale.NewContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(ContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1545,18 +1050,10 @@ func (params *ParamSet[N]) BindValidatedNotContainsInt8(info *FlagInfo, to *int8
 	params.BindInt8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int8]{
-		Fn: func(value int8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(lo.IndexOf(collection, value) >= 0) {
-				return nil
-			}
-
-			return locale.NewNotContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintNotContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1570,18 +1067,10 @@ func (params *ParamSet[N]) BindValidatedInt8GreaterThan(info *FlagInfo, to *int8
 	params.BindInt8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int8]{
-		Fn: func(value int8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value > threshold {
-				return nil
-			}
-
-			return locale.NewGreaterThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(GreaterThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintGreaterThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1595,18 +1084,10 @@ func (params *ParamSet[N]) BindValidatedInt8AtLeast(info *FlagInfo, to *int8, th
 	params.BindInt8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int8]{
-		Fn: func(value int8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= threshold {
-				return nil
-			}
-
-			return locale.NewAtLeastOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtLeastValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtLeast, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1620,18 +1101,10 @@ func (params *ParamSet[N]) BindValidatedInt8LessThan(info *FlagInfo, to *int8, t
 	params.BindInt8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int8]{
-		Fn: func(value int8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value < threshold {
-				return nil
-			}
-
-			return locale.NewLessThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(LessThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintLessThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1645,18 +1118,10 @@ func (params *ParamSet[N]) BindValidatedInt8AtMost(info *FlagInfo, to *int8, thr
 	params.BindInt8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[int8]{
-		Fn: func(value int8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value <= threshold {
-				return nil
-			}
-
-			return locale.NewAtMostOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtMostValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtMost, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1670,18 +1135,10 @@ func (params *ParamSet[N]) BindValidatedStringWithin(info *FlagInfo, to *string,
 	params.BindString(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[string]{
-		Fn: func(value string, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= low && value <= high {
-				return nil
-			}
-
-			return locale.NewWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(WithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1695,18 +1152,10 @@ func (params *ParamSet[N]) BindValidatedStringNotWithin(info *FlagInfo, to *stri
 	params.BindString(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[string]{
-		Fn: func(value string, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(value >= low && value <= high) {
-				return nil
-			}
-
-			return locale.NewNotWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotWithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintNotWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1720,18 +1169,10 @@ func (params *ParamSet[N]) BindValidatedContainsString(info *FlagInfo, to *strin
 	params.BindString(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[string]{
-		Fn: func(value string, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if lo.IndexOf(collection, value) >= 0 {
-				return nil
-			}
-
-			return locale.NewContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(ContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1745,18 +1186,10 @@ func (params *ParamSet[N]) BindValidatedNotContainsString(info *FlagInfo, to *st
 	params.BindString(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[string]{
-		Fn: func(value string, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(lo.IndexOf(collection, value) >= 0) {
-				return nil
-			}
-
-			return locale.NewNotContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintNotContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1770,18 +1203,10 @@ func (params *ParamSet[N]) BindValidatedStringIsMatch(info *FlagInfo, to *string
 	params.BindString(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[string]{
-		Fn: func(value string, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if regexp.MustCompile(pattern).Match([]byte(value)) {
-				return nil
-			}
-
-			return locale.NewMatchOptValidationError(info.FlagName(), value, pattern)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(MatchValidator(pattern)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: matchConstraint(ConstraintMatch, pattern),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1795,18 +1220,10 @@ func (params *ParamSet[N]) BindValidatedStringIsNotMatch(info *FlagInfo, to *str
 	params.BindString(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[string]{
-		Fn: func(value string, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(regexp.MustCompile(pattern).Match([]byte(value))) {
-				return nil
-			}
-
-			return locale.NewNotMatchOptValidationError(info.FlagName(), value, pattern)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotMatchValidator(pattern)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: matchConstraint(ConstraintNotMatch, pattern),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1820,18 +1237,10 @@ func (params *ParamSet[N]) BindValidatedStringGreaterThan(info *FlagInfo, to *st
 	params.BindString(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[string]{
-		Fn: func(value string, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value > threshold {
-				return nil
-			}
-
-			return locale.NewGreaterThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(GreaterThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintGreaterThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1845,18 +1254,10 @@ func (params *ParamSet[N]) BindValidatedStringAtLeast(info *FlagInfo, to *string
 	params.BindString(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[string]{
-		Fn: func(value string, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= threshold {
-				return nil
-			}
-
-			return locale.NewAtLeastOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtLeastValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtLeast, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1870,18 +1271,10 @@ func (params *ParamSet[N]) BindValidatedStringLessThan(info *FlagInfo, to *strin
 	params.BindString(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[string]{
-		Fn: func(value string, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value < threshold {
-				return nil
-			}
-
-			return locale.NewLessThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(LessThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintLessThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1895,18 +1288,10 @@ func (params *ParamSet[N]) BindValidatedStringAtMost(info *FlagInfo, to *string,
 	params.BindString(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[string]{
-		Fn: func(value string, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value <= threshold {
-				return nil
-			}
-
-			return locale.NewAtMostOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtMostValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtMost, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1920,18 +1305,10 @@ func (params *ParamSet[N]) BindValidatedUint16Within(info *FlagInfo, to *uint16,
 	params.BindUint16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint16]{
-		Fn: func(value uint16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= low && value <= high {
-				return nil
-			}
-
-			return locale.NewWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(WithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1945,18 +1322,10 @@ func (params *ParamSet[N]) BindValidatedUint16NotWithin(info *FlagInfo, to *uint
 	params.BindUint16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint16]{
-		Fn: func(value uint16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(value >= low && value <= high) {
-				return nil
-			}
-
-			return locale.NewNotWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotWithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintNotWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1970,18 +1339,10 @@ func (params *ParamSet[N]) BindValidatedContainsUint16(info *FlagInfo, to *uint1
 	params.BindUint16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint16]{
-		Fn: func(value uint16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if lo.IndexOf(collection, value) >= 0 {
-				return nil
-			}
-
-			return locale.NewContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(ContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -1995,18 +1356,10 @@ func (params *ParamSet[N]) BindValidatedNotContainsUint16(info *FlagInfo, to *ui
 	params.BindUint16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint16]{
-		Fn: func(value uint16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(lo.IndexOf(collection, value) >= 0) {
-				return nil
-			}
-
-			return locale.NewNotContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintNotContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2020,18 +1373,10 @@ func (params *ParamSet[N]) BindValidatedUint16GreaterThan(info *FlagInfo, to *ui
 	params.BindUint16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint16]{
-		Fn: func(value uint16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value > threshold {
-				return nil
-			}
-
-			return locale.NewGreaterThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(GreaterThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintGreaterThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2045,18 +1390,10 @@ func (params *ParamSet[N]) BindValidatedUint16AtLeast(info *FlagInfo, to *uint16
 	params.BindUint16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint16]{
-		Fn: func(value uint16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= threshold {
-				return nil
-			}
-
-			return locale.NewAtLeastOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtLeastValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtLeast, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2070,18 +1407,10 @@ func (params *ParamSet[N]) BindValidatedUint16LessThan(info *FlagInfo, to *uint1
 	params.BindUint16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint16]{
-		Fn: func(value uint16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value < threshold {
-				return nil
-			}
-
-			return locale.NewLessThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(LessThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintLessThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2095,18 +1424,10 @@ func (params *ParamSet[N]) BindValidatedUint16AtMost(info *FlagInfo, to *uint16,
 	params.BindUint16(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint16]{
-		Fn: func(value uint16, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value <= threshold {
-				return nil
-			}
-
-			return locale.NewAtMostOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtMostValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtMost, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2120,18 +1441,10 @@ func (params *ParamSet[N]) BindValidatedUint32Within(info *FlagInfo, to *uint32,
 	params.BindUint32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint32]{
-		Fn: func(value uint32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= low && value <= high {
-				return nil
-			}
-
-			return locale.NewWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(WithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2145,18 +1458,10 @@ func (params *ParamSet[N]) BindValidatedUint32NotWithin(info *FlagInfo, to *uint
 	params.BindUint32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint32]{
-		Fn: func(value uint32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(value >= low && value <= high) {
-				return nil
-			}
-
-			return locale.NewNotWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotWithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintNotWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2170,18 +1475,10 @@ func (params *ParamSet[N]) BindValidatedContainsUint32(info *FlagInfo, to *uint3
 	params.BindUint32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint32]{
-		Fn: func(value uint32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if lo.IndexOf(collection, value) >= 0 {
-				return nil
-			}
-
-			return locale.NewContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(ContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2195,18 +1492,10 @@ func (params *ParamSet[N]) BindValidatedNotContainsUint32(info *FlagInfo, to *ui
 	params.BindUint32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint32]{
-		Fn: func(value uint32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(lo.IndexOf(collection, value) >= 0) {
-				return nil
-			}
-
-			return locale.NewNotContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintNotContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2220,18 +1509,10 @@ func (params *ParamSet[N]) BindValidatedUint32GreaterThan(info *FlagInfo, to *ui
 	params.BindUint32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint32]{
-		Fn: func(value uint32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value > threshold {
-				return nil
-			}
-
-			return locale.NewGreaterThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(GreaterThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintGreaterThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2245,18 +1526,10 @@ func (params *ParamSet[N]) BindValidatedUint32AtLeast(info *FlagInfo, to *uint32
 	params.BindUint32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint32]{
-		Fn: func(value uint32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= threshold {
-				return nil
-			}
-
-			return locale.NewAtLeastOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtLeastValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtLeast, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2270,18 +1543,10 @@ func (params *ParamSet[N]) BindValidatedUint32LessThan(info *FlagInfo, to *uint3
 	params.BindUint32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint32]{
-		Fn: func(value uint32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value < threshold {
-				return nil
-			}
-
-			return locale.NewLessThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(LessThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintLessThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2295,18 +1560,10 @@ func (params *ParamSet[N]) BindValidatedUint32AtMost(info *FlagInfo, to *uint32,
 	params.BindUint32(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint32]{
-		Fn: func(value uint32, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value <= threshold {
-				return nil
-			}
-
-			return locale.NewAtMostOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtMostValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtMost, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2320,18 +1577,10 @@ func (params *ParamSet[N]) BindValidatedUint64Within(info *FlagInfo, to *uint64,
 	params.BindUint64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint64]{
-		Fn: func(value uint64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= low && value <= high {
-				return nil
-			}
-
-			return locale.NewWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(WithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2345,18 +1594,10 @@ func (params *ParamSet[N]) BindValidatedUint64NotWithin(info *FlagInfo, to *uint
 	params.BindUint64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint64]{
-		Fn: func(value uint64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(value >= low && value <= high) {
-				return nil
-			}
-
-			return locale.NewNotWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotWithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintNotWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2370,18 +1611,10 @@ func (params *ParamSet[N]) BindValidatedContainsUint64(info *FlagInfo, to *uint6
 	params.BindUint64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint64]{
-		Fn: func(value uint64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if lo.IndexOf(collection, value) >= 0 {
-				return nil
-			}
-
-			return locale.NewContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(ContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2395,18 +1628,10 @@ func (params *ParamSet[N]) BindValidatedNotContainsUint64(info *FlagInfo, to *ui
 	params.BindUint64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint64]{
-		Fn: func(value uint64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(lo.IndexOf(collection, value) >= 0) {
-				return nil
-			}
-
-			return locale.NewNotContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintNotContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2420,18 +1645,10 @@ func (params *ParamSet[N]) BindValidatedUint64GreaterThan(info *FlagInfo, to *ui
 	params.BindUint64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint64]{
-		Fn: func(value uint64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value > threshold {
-				return nil
-			}
-
-			return locale.NewGreaterThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(GreaterThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintGreaterThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2445,18 +1662,10 @@ func (params *ParamSet[N]) BindValidatedUint64AtLeast(info *FlagInfo, to *uint64
 	params.BindUint64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint64]{
-		Fn: func(value uint64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= threshold {
-				return nil
-			}
-
-			return locale.NewAtLeastOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtLeastValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtLeast, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2470,18 +1679,10 @@ func (params *ParamSet[N]) BindValidatedUint64LessThan(info *FlagInfo, to *uint6
 	params.BindUint64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint64]{
-		Fn: func(value uint64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value < threshold {
-				return nil
-			}
-
-			return locale.NewLessThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(LessThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintLessThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2495,18 +1696,10 @@ func (params *ParamSet[N]) BindValidatedUint64AtMost(info *FlagInfo, to *uint64,
 	params.BindUint64(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint64]{
-		Fn: func(value uint64, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value <= threshold {
-				return nil
-			}
-
-			return locale.NewAtMostOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtMostValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtMost, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2520,18 +1713,10 @@ func (params *ParamSet[N]) BindValidatedUint8Within(info *FlagInfo, to *uint8, l
 	params.BindUint8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint8]{
-		Fn: func(value uint8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= low && value <= high {
-				return nil
-			}
-
-			return locale.NewWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(WithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2545,18 +1730,10 @@ func (params *ParamSet[N]) BindValidatedUint8NotWithin(info *FlagInfo, to *uint8
 	params.BindUint8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint8]{
-		Fn: func(value uint8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(value >= low && value <= high) {
-				return nil
-			}
-
-			return locale.NewNotWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotWithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintNotWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2570,18 +1747,10 @@ func (params *ParamSet[N]) BindValidatedContainsUint8(info *FlagInfo, to *uint8,
 	params.BindUint8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint8]{
-		Fn: func(value uint8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if lo.IndexOf(collection, value) >= 0 {
-				return nil
-			}
-
-			return locale.NewContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(ContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2595,18 +1764,10 @@ func (params *ParamSet[N]) BindValidatedNotContainsUint8(info *FlagInfo, to *uin
 	params.BindUint8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint8]{
-		Fn: func(value uint8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(lo.IndexOf(collection, value) >= 0) {
-				return nil
-			}
-
-			return locale.NewNotContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintNotContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2620,18 +1781,10 @@ func (params *ParamSet[N]) BindValidatedUint8GreaterThan(info *FlagInfo, to *uin
 	params.BindUint8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint8]{
-		Fn: func(value uint8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value > threshold {
-				return nil
-			}
-
-			return locale.NewGreaterThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(GreaterThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintGreaterThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2645,18 +1798,10 @@ func (params *ParamSet[N]) BindValidatedUint8AtLeast(info *FlagInfo, to *uint8,
 	params.BindUint8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint8]{
-		Fn: func(value uint8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= threshold {
-				return nil
-			}
-
-			return locale.NewAtLeastOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtLeastValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtLeast, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2670,18 +1815,10 @@ func (params *ParamSet[N]) BindValidatedUint8LessThan(info *FlagInfo, to *uint8,
 	params.BindUint8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint8]{
-		Fn: func(value uint8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value < threshold {
-				return nil
-			}
-
-			return locale.NewLessThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(LessThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintLessThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2695,18 +1832,10 @@ func (params *ParamSet[N]) BindValidatedUint8AtMost(info *FlagInfo, to *uint8, t
 	params.BindUint8(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint8]{
-		Fn: func(value uint8, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value <= threshold {
-				return nil
-			}
-
-			return locale.NewAtMostOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtMostValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtMost, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2720,18 +1849,10 @@ func (params *ParamSet[N]) BindValidatedUintWithin(info *FlagInfo, to *uint, low
 	params.BindUint(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint]{
-		Fn: func(value uint, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= low && value <= high {
-				return nil
-			}
-
-			return locale.NewWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(WithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2745,18 +1866,10 @@ func (params *ParamSet[N]) BindValidatedUintNotWithin(info *FlagInfo, to *uint,
 	params.BindUint(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint]{
-		Fn: func(value uint, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(value >= low && value <= high) {
-				return nil
-			}
-
-			return locale.NewNotWithinOptValidationError(info.FlagName(), value, low, high)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotWithinValidator(low, high)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: rangeConstraint(ConstraintNotWithin, low, high),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2770,18 +1883,10 @@ func (params *ParamSet[N]) BindValidatedContainsUint(info *FlagInfo, to *uint, c
 	params.BindUint(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint]{
-		Fn: func(value uint, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if lo.IndexOf(collection, value) >= 0 {
-				return nil
-			}
-
-			return locale.NewContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(ContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2795,18 +1900,10 @@ func (params *ParamSet[N]) BindValidatedNotContainsUint(info *FlagInfo, to *uint
 	params.BindUint(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint]{
-		Fn: func(value uint, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if !(lo.IndexOf(collection, value) >= 0) {
-				return nil
-			}
-
-			return locale.NewNotContainsOptValidationError(info.FlagName(), value, collection)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(NotContainsValidator(collection)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: memberConstraint(ConstraintNotContains, collection),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2820,18 +1917,10 @@ func (params *ParamSet[N]) BindValidatedUintGreaterThan(info *FlagInfo, to *uint
 	params.BindUint(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint]{
-		Fn: func(value uint, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value > threshold {
-				return nil
-			}
-
-			return locale.NewGreaterThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(GreaterThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintGreaterThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2845,18 +1934,10 @@ func (params *ParamSet[N]) BindValidatedUintAtLeast(info *FlagInfo, to *uint, th
 	params.BindUint(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint]{
-		Fn: func(value uint, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value >= threshold {
-				return nil
-			}
-
-			return locale.NewAtLeastOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtLeastValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtLeast, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2870,18 +1951,10 @@ func (params *ParamSet[N]) BindValidatedUintLessThan(info *FlagInfo, to *uint, t
 	params.BindUint(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint]{
-		Fn: func(value uint, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value < threshold {
-				return nil
-			}
-
-			return locale.NewLessThanOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(LessThanValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintLessThan, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -2895,18 +1968,10 @@ func (params *ParamSet[N]) BindValidatedUintAtMost(info *FlagInfo, to *uint, thr
 	params.BindUint(info, to)
 
 	wrapper := GenericOptionValidatorWrapper[uint]{
-		Fn: func(value uint, flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-			if value <= threshold {
-				return nil
-			}
-
-			return locale.NewAtMostOptValidationError(info.FlagName(), value, threshold)
-		},
-		Value: to,
-		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+		Fn:         whenChanged(AtMostValidator(threshold)),
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: thresholdConstraint(ConstraintAtMost, threshold),
 	}
 	params.validators.Add(info.FlagName(), wrapper)
 
@@ -0,0 +1,226 @@
+package assistant
+
+import (
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+)
+
+// TimeOptions options that determine how time flag values are parsed.
+type TimeOptions struct {
+	// Layouts are the layouts (see time.Parse) tried in order, to parse an
+	// absolute time. Defaults to time.RFC3339, time.DateTime and time.DateOnly.
+	// The first layout is also used to format the time, so if no layouts are
+	// specified, time.RFC3339 is used.
+	//
+	Layouts []string
+
+	// Location is the location of times parsed with a layout that does not
+	// contain a time zone, defaults to time.Local.
+	//
+	Location *time.Location
+
+	// Now returns the time that relative times are relative to, defaults
+	// to time.Now.
+	//
+	Now func() time.Time
+}
+
+// TimeOptionFn definition of a client defined function to set TimeOptions.
+type TimeOptionFn func(o *TimeOptions)
+
+// relativeDaysRx matches relative times defined in days or weeks, which
+// are not supported by time.ParseDuration.
+var relativeDaysRx = regexp.MustCompile(`^([+-])(\d+)([dw])$`)
+
+// TimeCodec creates a ValueCodec for time.Time, which accepts absolute times
+// in any of the layouts, "now" or a time relative to now, being a signed
+// duration (see time.ParseDuration), eg "-90m", or a signed number of days
+// or weeks, eg "-3d", "+2w".
+func TimeCodec(options ...TimeOptionFn) *ValueCodec[time.Time] {
+	option := TimeOptions{
+		Layouts:  []string{time.RFC3339, time.DateTime, time.DateOnly},
+		Location: time.Local,
+		Now:      time.Now,
+	}
+
+	for _, functionalOption := range options {
+		functionalOption(&option)
+	}
+
+	if len(option.Layouts) == 0 {
+		option.Layouts = []string{time.RFC3339}
+	}
+
+	return &ValueCodec[time.Time]{
+		Parse: func(s string) (time.Time, error) {
+			return parseTime(s, &option)
+		},
+		Format: func(t time.Time) string {
+			if t.IsZero() {
+				return ""
+			}
+
+			return t.Format(option.Layouts[0])
+		},
+		Type: "time",
+	}
+}
+
+func parseTime(s string, option *TimeOptions) (time.Time, error) {
+	value := strings.TrimSpace(s)
+
+	if value == "now" {
+		return option.Now(), nil
+	}
+
+	if match := relativeDaysRx.FindStringSubmatch(value); match != nil {
+		days, _ := strconv.Atoi(match[2])
+
+		if match[3] == "w" {
+			days *= 7
+		}
+
+		if match[1] == "-" {
+			days = -days
+		}
+
+		return option.Now().AddDate(0, 0, days), nil
+	}
+
+	if strings.HasPrefix(value, "-") || strings.HasPrefix(value, "+") {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return option.Now().Add(duration), nil
+		}
+	}
+
+	for _, layout := range option.Layouts {
+		if t, err := time.ParseInLocation(layout, value, option.Location); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, locale.NewInvalidTimeValueValidationError(s, option.Layouts)
+}
+
+// BindTime binds time.Time flag with a shorthand if 'info.Short' has been
+// set otherwise binds without a short name. Values may be absolute or
+// relative, see TimeCodec.
+func (params *ParamSet[N]) BindTime(info *FlagInfo, to *time.Time, options ...TimeOptionFn) *ParamSet[N] {
+	return BindValue(params, info, to, TimeCodec(options...))
+}
+
+// BindValidatedTime binds time.Time flag with a shorthand if 'info.Short' has
+// been set otherwise binds without a short name. Client can provide a function
+// to validate option values of time.Time type.
+func (params *ParamSet[N]) BindValidatedTime(info *FlagInfo, to *time.Time,
+	validator ValueValidatorFn[time.Time], options ...TimeOptionFn,
+) OptionValidator {
+	return BindValidatedValue(params, info, to, TimeCodec(options...), validator)
+}
+
+// URLCodec is the ValueCodec for url.URL.
+var URLCodec = &ValueCodec[url.URL]{
+	Parse: func(s string) (url.URL, error) {
+		u, err := url.Parse(s)
+		if err != nil {
+			return url.URL{}, err
+		}
+
+		return *u, nil
+	},
+	Format: func(u url.URL) string {
+		return u.String()
+	},
+	Type: "url",
+}
+
+// BindURL binds url.URL flag with a shorthand if 'info.Short' has been set
+// otherwise binds without a short name. If schemes are specified, a validator
+// is registered that ensures that the scheme of the url provided is one of
+// them (case insensitive).
+func (params *ParamSet[N]) BindURL(info *FlagInfo, to *url.URL, schemes ...string) *ParamSet[N] {
+	if len(schemes) == 0 {
+		return BindValue(params, info, to, URLCodec)
+	}
+
+	BindValidatedValue(params, info, to, URLCodec, schemeValidator(nil, schemes))
+
+	return params
+}
+
+// BindValidatedURL binds url.URL flag with a shorthand if 'info.Short' has
+// been set otherwise binds without a short name. Client can provide a function
+// to validate option values of url.URL type, which is invoked after the scheme
+// has been checked against the schemes, if specified.
+func (params *ParamSet[N]) BindValidatedURL(info *FlagInfo, to *url.URL,
+	validator ValueValidatorFn[url.URL], schemes ...string,
+) OptionValidator {
+	return BindValidatedValue(params, info, to, URLCodec, schemeValidator(validator, schemes))
+}
+
+func schemeValidator(validator ValueValidatorFn[url.URL], schemes []string) ValueValidatorFn[url.URL] {
+	return func(value url.URL, flag *pflag.Flag) error {
+		if len(schemes) > 0 && value.String() != "" &&
+			!slices.ContainsFunc(schemes, func(scheme string) bool {
+				return strings.EqualFold(scheme, value.Scheme)
+			}) {
+			return locale.NewURLSchemeOptValidationError(
+				flag.Name, value.String(), value.Scheme, schemes,
+			)
+		}
+
+		if validator != nil {
+			return validator(value, flag)
+		}
+
+		return nil
+	}
+}
+
+// RegexpCodec is the ValueCodec for a compiled regular expression.
+var RegexpCodec = &ValueCodec[*regexp.Regexp]{
+	Parse: regexp.Compile,
+	Format: func(rx *regexp.Regexp) string {
+		if rx == nil {
+			return ""
+		}
+
+		return rx.String()
+	},
+	Type: "regexp",
+}
+
+// BindRegexp binds a regular expression flag with a shorthand if 'info.Short'
+// has been set otherwise binds without a short name. The pattern is compiled
+// as the flag is parsed, so an invalid pattern is rejected at that point and
+// the compiled *regexp.Regexp is stored, remaining nil if the flag is not
+// provided and there is no default. 'info.Default' may be either a pattern
+// string or a *regexp.Regexp.
+func (params *ParamSet[N]) BindRegexp(info *FlagInfo, to **regexp.Regexp) *ParamSet[N] {
+	return BindValue(params, info, to, RegexpCodec)
+}
+
+// BindValidatedRegexp binds a regular expression flag (see BindRegexp). Client
+// can provide a function to validate the compiled regular expression.
+func (params *ParamSet[N]) BindValidatedRegexp(info *FlagInfo, to **regexp.Regexp,
+	validator ValueValidatorFn[*regexp.Regexp],
+) OptionValidator {
+	params.BindRegexp(info, to)
+
+	wrapper := GenericOptionValidatorWrapper[*regexp.Regexp]{
+		Fn:    validator,
+		Value: to,
+		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+	}
+	params.validators.Add(info.FlagName(), wrapper)
+
+	return wrapper
+}
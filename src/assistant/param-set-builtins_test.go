@@ -0,0 +1,223 @@
+package assistant_test
+
+import (
+	"net/url"
+	"regexp"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant"
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/internal/lab"
+	"github.com/snivilised/li18ngo"
+)
+
+type BuiltinsParameterSet struct {
+	Since    time.Time
+	Endpoint url.URL
+	Pattern  *regexp.Regexp
+}
+
+var referenceNow = time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+type timeTE struct {
+	given    string
+	should   string
+	value    string
+	expected time.Time
+}
+
+var _ = Describe("Builtin binders", Ordered, func() {
+	var (
+		rootCommand *cobra.Command
+		paramSet    *assistant.ParamSet[BuiltinsParameterSet]
+	)
+
+	BeforeAll(func() {
+		if err := li18ngo.Use(func(o *li18ngo.UseOptions) {
+			o.From = li18ngo.LoadFrom{
+				Path: lab.Path(lab.Repo("../.."), "Test/data/l10n"),
+				Sources: li18ngo.TranslationFiles{
+					locale.CobrassSourceID: li18ngo.TranslationSource{Name: "test"},
+				},
+			}
+		}); err != nil {
+			Fail(err.Error())
+		}
+	})
+
+	BeforeEach(func() {
+		rootCommand = &cobra.Command{
+			Use:          "poke",
+			SilenceUsage: true,
+			RunE: func(_ *cobra.Command, _ []string) error {
+				return paramSet.Validate()
+			},
+		}
+		paramSet = assistant.NewParamSet[BuiltinsParameterSet](rootCommand)
+		paramSet.BindTime(
+			assistant.NewFlagInfo("since time of earliest entry", "s", time.Time{}),
+			&paramSet.Native.Since,
+			func(o *assistant.TimeOptions) {
+				o.Location = time.UTC
+				o.Now = func() time.Time { return referenceNow }
+			},
+		).BindURL(
+			assistant.NewFlagInfo("endpoint url of the service", "e", url.URL{}),
+			&paramSet.Native.Endpoint,
+			"https", "http",
+		).BindRegexp(
+			assistant.NewFlagInfo("pattern to match", "p", "^foo"),
+			&paramSet.Native.Pattern,
+		)
+	})
+
+	DescribeTable("BindTime",
+		func(entry *timeTE) {
+			_, err := lab.ExecuteCommand(rootCommand, "--since", entry.value)
+
+			Expect(err).To(Succeed())
+			Expect(paramSet.Native.Since).To(BeTemporally("==", entry.expected))
+		},
+		func(entry *timeTE) string {
+			return "🧪 --> given: " + entry.given + ", should: " + entry.should
+		},
+
+		Entry(nil, &timeTE{
+			given:    "date",
+			should:   "parse date",
+			value:    "2024-01-02",
+			expected: time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC),
+		}),
+
+		Entry(nil, &timeTE{
+			given:    "rfc3339",
+			should:   "parse date time",
+			value:    "2024-01-02T03:04:05Z",
+			expected: time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC),
+		}),
+
+		Entry(nil, &timeTE{
+			given:    "relative days",
+			should:   "subtract days from now",
+			value:    "-3d",
+			expected: referenceNow.AddDate(0, 0, -3),
+		}),
+
+		Entry(nil, &timeTE{
+			given:    "relative weeks",
+			should:   "add weeks to now",
+			value:    "+2w",
+			expected: referenceNow.AddDate(0, 0, 14),
+		}),
+
+		Entry(nil, &timeTE{
+			given:    "relative duration",
+			should:   "subtract duration from now",
+			value:    "-90m",
+			expected: referenceNow.Add(-90 * time.Minute),
+		}),
+
+		Entry(nil, &timeTE{
+			given:    "now",
+			should:   "be now",
+			value:    "now",
+			expected: referenceNow,
+		}),
+	)
+
+	Context("given: invalid time", func() {
+		It("🧪 should: return parse error", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--since", "yesterday")
+
+			Expect(err).To(MatchError(ContainSubstring("'yesterday' is not a valid time")))
+		})
+
+		It("🧪 should: identify flag in validation failure", func() {
+			err := rootCommand.Flags().Lookup("since").Value.Set("yesterday")
+
+			structured, ok := err.(locale.StructuredValidationError)
+			Expect(ok).To(BeTrue())
+			Expect(structured.Failure().Flag).To(Equal("since"))
+			Expect(structured.Failure().Constraint).To(Equal(locale.ConstraintTime))
+		})
+	})
+
+	Context("given: empty layouts", func() {
+		It("🧪 should: fall back to RFC3339", func() {
+			codec := assistant.TimeCodec(func(o *assistant.TimeOptions) {
+				o.Layouts = []string{}
+			})
+			t, err := codec.Parse("2024-03-01T10:00:00Z")
+
+			Expect(err).To(Succeed())
+			Expect(codec.Format(t)).To(Equal("2024-03-01T10:00:00Z"))
+		})
+	})
+
+	Context("given: url with allowed scheme", func() {
+		It("🧪 should: bind url", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--endpoint", "HTTPS://example.com/api")
+
+			Expect(err).To(Succeed())
+			Expect(paramSet.Native.Endpoint.Host).To(Equal("example.com"))
+		})
+	})
+
+	Context("given: url with disallowed scheme", func() {
+		It("🧪 should: return url scheme validation error", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--endpoint", "ftp://example.com")
+
+			Expect(err).To(HaveOccurred())
+			_, ok := err.(locale.URLSchemeOptValidationBehaviourQuery)
+			Expect(ok).To(BeTrue())
+		})
+	})
+
+	Context("given: regexp", func() {
+		It("🧪 should: store compiled regexp", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--pattern", "ba+r$")
+
+			Expect(err).To(Succeed())
+			Expect(paramSet.Native.Pattern.MatchString("baaar")).To(BeTrue())
+		})
+
+		It("🧪 should: compile default", func() {
+			_, err := lab.ExecuteCommand(rootCommand)
+
+			Expect(err).To(Succeed())
+			Expect(paramSet.Native.Pattern.String()).To(Equal("^foo"))
+			Expect(rootCommand.Flags().Lookup("pattern").DefValue).To(Equal("^foo"))
+		})
+
+		It("🧪 should: compile default regexp", func() {
+			command := &cobra.Command{Use: "poke"}
+			ps := assistant.NewParamSet[BuiltinsParameterSet](command)
+			ps.BindRegexp(
+				assistant.NewFlagInfo("pattern to match", "p", regexp.MustCompile("^bar")),
+				&ps.Native.Pattern,
+			)
+
+			Expect(ps.Native.Pattern.String()).To(Equal("^bar"))
+			Expect(command.Flags().Lookup("pattern").DefValue).To(Equal("^bar"))
+		})
+
+		It("🧪 should: panic with native error given invalid default", func() {
+			command := &cobra.Command{Use: "poke"}
+			ps := assistant.NewParamSet[BuiltinsParameterSet](command)
+
+			Expect(func() {
+				ps.BindRegexp(assistant.NewFlagInfo("pattern to match", "p", "("), &ps.Native.Pattern)
+			}).To(PanicWith(MatchError(ContainSubstring("invalid default '(' for flag 'pattern'"))))
+		})
+
+		It("🧪 should: reject invalid pattern", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--pattern", "(")
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
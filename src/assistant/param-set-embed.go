@@ -0,0 +1,75 @@
+package assistant
+
+import (
+	"reflect"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+)
+
+// ParamSetHost is a non generic view of a ParamSet, which can host parameter
+// sets embedded within its native parameter set (see Embed).
+type ParamSetHost interface {
+	hosted() *hostedState
+}
+
+// hostedState is the state of the host that is shared with the parameter
+// sets it hosts.
+type hostedState struct {
+	validators *ValidatorContainer
+	enums      map[string]EnumDescriptor
+	membership *membership
+	shortFlags *shortFlagPolicyHolder
+	required   *requirements
+	valueFiles *flagRefs
+	flagSet    *pflag.FlagSet
+	command    *cobra.Command
+}
+
+func (params *ParamSet[N]) hosted() *hostedState {
+	return &hostedState{
+		validators: params.validators,
+		enums:      params.enums,
+		membership: params.membership,
+		shortFlags: params.shortFlags,
+		required:   params.required,
+		valueFiles: params.valueFiles,
+		flagSet:    params.FlagSet,
+		command:    params.Command,
+	}
+}
+
+// Embed creates a parameter set whose native parameter set is embedded
+// inside the native parameter set of the host, typically a field of the
+// host's native struct. The embedded parameter set shares the host's
+// command, flag set and validators, so that flags bound by it (eg by a
+// store family's BindAll) are registered against the host and are
+// validated by the host's Validate. The embedded parameter set does not need to be
+// registered with the CobraContainer.
+//
+// panics if F is not a struct.
+func Embed[F any](host ParamSetHost, native *F) *ParamSet[F] {
+	state := host.hosted()
+
+	if reflect.TypeOf(*native).Kind() != reflect.Struct {
+		typeName := reflect.TypeOf(*native).Name()
+
+		panic(
+			locale.NewParamSetObjectMustBeStructNativeError(state.command.Name(), typeName),
+		)
+	}
+
+	return &ParamSet[F]{
+		validators: state.validators,
+		enums:      state.enums,
+		membership: state.membership,
+		shortFlags: state.shortFlags,
+		required:   state.required,
+		valueFiles: state.valueFiles,
+		Native:     native,
+		FlagSet:    state.flagSet,
+		Command:    state.command,
+	}
+}
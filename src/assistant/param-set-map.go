@@ -0,0 +1,249 @@
+package assistant
+
+import (
+	"cmp"
+	"encoding/csv"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+)
+
+// mapValue is the pflag.Value that binds a map of K to V, from entries of
+// the form "key=value". The flag may be repeated and each occurrence may
+// contain multiple comma separated entries, eg "--label a=1,b=2 --label c=3",
+// which are parsed as csv, as per pflag's StringToString.
+type mapValue[K, V Scalar] struct {
+	to      *map[K]V
+	flag    string
+	changed bool
+}
+
+func (v *mapValue[K, V]) String() string {
+	if len(*v.to) == 0 {
+		return ""
+	}
+
+	entries := make([]string, 0, len(*v.to))
+	for _, key := range slices.SortedFunc(maps.Keys(*v.to), compareScalars) {
+		entries = append(entries, fmt.Sprintf("%v=%v", key, (*v.to)[key]))
+	}
+
+	builder := &strings.Builder{}
+	writer := csv.NewWriter(builder)
+	_ = writer.Write(entries)
+	writer.Flush()
+
+	return strings.TrimRight(builder.String(), "\n")
+}
+
+func (v *mapValue[K, V]) Set(s string) error {
+	// the first occurrence replaces the default, subsequent occurrences
+	// accumulate
+	//
+	if !v.changed || *v.to == nil {
+		*v.to = make(map[K]V)
+		v.changed = true
+	}
+
+	entries, readErr := csv.NewReader(strings.NewReader(s)).Read()
+	if readErr != nil {
+		return locale.NewInvalidMapEntryValidationError(v.flag, s)
+	}
+
+	for _, entry := range entries {
+		k, val, found := strings.Cut(entry, "=")
+		if !found || strings.TrimSpace(k) == "" {
+			return locale.NewInvalidMapEntryValidationError(v.flag, entry)
+		}
+
+		key, err := parseScalar[K](strings.TrimSpace(k))
+		if err != nil {
+			return locale.NewInvalidMapEntryValidationError(v.flag, entry)
+		}
+
+		value, err := parseScalar[V](strings.TrimSpace(val))
+		if err != nil {
+			return locale.NewInvalidMapEntryValidationError(v.flag, entry)
+		}
+
+		(*v.to)[key] = value
+	}
+
+	return nil
+}
+
+func (v *mapValue[K, V]) Type() string {
+	return "key=value"
+}
+
+func compareScalars[T Scalar](a, b T) int {
+	return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// MapOptions options that determine how the entries of a map flag are
+// validated.
+type MapOptions[K, V Scalar] struct {
+	// Keys is the allow-list of keys, if defined, any other key fails
+	// validation.
+	//
+	Keys []K
+
+	// KeyPattern is a regular expression that all keys must match
+	//
+	KeyPattern string
+
+	// ValueValidator validates each value of the map. The predefined
+	// validators (eg WithinValidator) perform the same validation as the
+	// generated helpers. The name of the flag passed to the validator is
+	// of the form "flag[key]", so that the failing entry can be identified.
+	//
+	ValueValidator ValueValidatorFn[V]
+}
+
+// MapOptionFn definition of a client defined function to set MapOptions.
+type MapOptionFn[K, V Scalar] func(o *MapOptions[K, V])
+
+// BindMap binds a map flag, with a shorthand if 'info.Short' has been set
+// otherwise binds without a short name. Entries are specified as "key=value"
+// and the flag may be repeated, eg "--label tier=gold --label region=eu".
+// Multiple entries may also be separated by commas, which are parsed as csv,
+// so an entry containing a comma must be quoted, eg '--label "tags=a,b"'.
+// Keys and values are parsed according to K and V. If set, 'info.Default'
+// must be a map[K]V, which is replaced, not merged, by entries specified
+// on the command line. If any options are specified, a validator is
+// registered that checks each entry against them. Eg:
+//
+//	assistant.BindMap(paramSet,
+//		assistant.NewFlagInfo("limits of resources", "l", map[string]int{}),
+//		&paramSet.Native.Limits,
+//		func(o *assistant.MapOptions[string, int]) {
+//			o.Keys = []string{"cpu", "memory"}
+//			o.ValueValidator = assistant.AtLeastValidator(1)
+//		},
+//	)
+func BindMap[N any, K, V Scalar](params *ParamSet[N], info *FlagInfo, to *map[K]V,
+	options ...MapOptionFn[K, V],
+) *ParamSet[N] {
+	if len(options) == 0 {
+		bindMap(params, info, to)
+
+		return params
+	}
+
+	BindValidatedMap(params, info, to, nil, options...)
+
+	return params
+}
+
+// BindValidatedMap binds a map flag (see BindMap). Client can provide a
+// function to validate the whole map, which is invoked after the entries
+// have been checked against the options.
+func BindValidatedMap[N any, K, V Scalar](params *ParamSet[N], info *FlagInfo, to *map[K]V,
+	validator ValueValidatorFn[map[K]V], options ...MapOptionFn[K, V],
+) OptionValidator {
+	option := MapOptions[K, V]{}
+
+	for _, functionalOption := range options {
+		functionalOption(&option)
+	}
+
+	bindMap(params, info, to)
+
+	wrapper := GenericOptionValidatorWrapper[map[K]V]{
+		Fn:    mapValidator(&option, validator),
+		Value: to,
+		Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+	}
+	params.validators.Add(info.FlagName(), wrapper)
+
+	return wrapper
+}
+
+func bindMap[N any, K, V Scalar](params *ParamSet[N], info *FlagInfo, to *map[K]V) {
+	if info.Default != nil {
+		*to = maps.Clone(info.Default.(map[K]V))
+	}
+
+	BindFlagValue(params, info, &mapValue[K, V]{to: to, flag: info.FlagName()})
+}
+
+func mapValidator[K, V Scalar](option *MapOptions[K, V],
+	validator ValueValidatorFn[map[K]V],
+) ValueValidatorFn[map[K]V] {
+	var rx *regexp.Regexp
+
+	if option.KeyPattern != "" {
+		rx = regexp.MustCompile(option.KeyPattern)
+	}
+
+	return func(value map[K]V, flag *pflag.Flag) error {
+		for _, key := range slices.SortedFunc(maps.Keys(value), compareScalars) {
+			if len(option.Keys) > 0 && !slices.Contains(option.Keys, key) {
+				return locale.NewContainsOptValidationError(flag.Name, key, option.Keys)
+			}
+
+			if rx != nil && !rx.MatchString(fmt.Sprint(key)) {
+				return locale.NewMatchOptValidationError(flag.Name, fmt.Sprint(key), option.KeyPattern)
+			}
+
+			if option.ValueValidator != nil {
+				entry := *flag
+				entry.Name = fmt.Sprintf("%v[%v]", flag.Name, key)
+
+				if err := option.ValueValidator(value[key], &entry); err != nil {
+					return err
+				}
+			}
+		}
+
+		if validator != nil {
+			return validator(value, flag)
+		}
+
+		return nil
+	}
+}
+
+// MergeMap merges map values, typically from config, into the map bound by
+// BindMap. If the flag has been specified on the command line, its entries
+// take precedence, otherwise the entries of 'from' take precedence over the
+// default entries. Keys and values of 'from' not already of type K and V are
+// parsed from their string representation.
+func MergeMap[K, V Scalar, S any](to *map[K]V, from map[string]S, flag *pflag.Flag) error {
+	if *to == nil {
+		*to = make(map[K]V, len(from))
+	}
+
+	name := ""
+	if flag != nil {
+		name = flag.Name
+	}
+
+	for k, v := range from {
+		key, err := parseScalar[K](k)
+		if err != nil {
+			return locale.NewInvalidMapEntryValidationError(name, fmt.Sprintf("%v=%v", k, v))
+		}
+
+		if _, found := (*to)[key]; found && flag != nil && flag.Changed {
+			continue
+		}
+
+		value, ok := any(v).(V)
+		if !ok {
+			if value, err = parseScalar[V](fmt.Sprint(v)); err != nil {
+				return locale.NewInvalidMapEntryValidationError(name, fmt.Sprintf("%v=%v", k, v))
+			}
+		}
+
+		(*to)[key] = value
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package assistant_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant"
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/internal/lab"
+	"github.com/snivilised/cobrass/src/internal/third/lo"
+	"github.com/snivilised/li18ngo"
+)
+
+type MapParameterSet struct {
+	Labels   map[string]string
+	Limits   map[string]int
+	Timeouts map[string]time.Duration
+}
+
+type mapTE struct {
+	given    string
+	args     []string
+	expected map[string]int
+	invalid  bool
+}
+
+var _ = Describe("Map", Ordered, func() {
+	var (
+		rootCommand *cobra.Command
+		paramSet    *assistant.ParamSet[MapParameterSet]
+	)
+
+	BeforeAll(func() {
+		if err := li18ngo.Use(func(o *li18ngo.UseOptions) {
+			o.From = li18ngo.LoadFrom{
+				Path: lab.Path(lab.Repo("../.."), "Test/data/l10n"),
+				Sources: li18ngo.TranslationFiles{
+					locale.CobrassSourceID: li18ngo.TranslationSource{Name: "test"},
+				},
+			}
+		}); err != nil {
+			Fail(err.Error())
+		}
+	})
+
+	BeforeEach(func() {
+		rootCommand = &cobra.Command{
+			Use:          "poke",
+			SilenceUsage: true,
+			RunE: func(_ *cobra.Command, _ []string) error {
+				return paramSet.Validate()
+			},
+		}
+		paramSet = assistant.NewParamSet[MapParameterSet](rootCommand)
+		assistant.BindMap(paramSet,
+			assistant.NewFlagInfo("labels to apply", "l", map[string]string{"tier": "bronze"}),
+			&paramSet.Native.Labels,
+			func(o *assistant.MapOptions[string, string]) {
+				o.KeyPattern = `^[a-z]+$`
+			},
+		)
+		assistant.BindMap(paramSet,
+			assistant.NewFlagInfo("limits of resources", "r", nil),
+			&paramSet.Native.Limits,
+			func(o *assistant.MapOptions[string, int]) {
+				o.Keys = []string{"cpu", "memory"}
+				o.ValueValidator = assistant.WithinValidator(1, 64)
+			},
+		)
+		assistant.BindMap(paramSet,
+			assistant.NewFlagInfo("timeouts per stage", "t", nil),
+			&paramSet.Native.Timeouts,
+		)
+	})
+
+	DescribeTable("limits",
+		func(entry *mapTE) {
+			_, err := lab.ExecuteCommand(rootCommand, entry.args...)
+
+			if entry.invalid {
+				Expect(err).To(HaveOccurred())
+
+				return
+			}
+
+			Expect(err).To(Succeed())
+			Expect(paramSet.Native.Limits).To(Equal(entry.expected))
+		},
+		func(entry *mapTE) string {
+			return "🧪 --> given: " + entry.given + ", should: " +
+				lo.Ternary(entry.invalid, "fail", "bind map")
+		},
+
+		Entry(nil, &mapTE{
+			given:    "repeated flag",
+			args:     []string{"--limits", "cpu=4", "--limits", "memory=16"},
+			expected: map[string]int{"cpu": 4, "memory": 16},
+		}),
+		Entry(nil, &mapTE{
+			given:    "comma separated entries",
+			args:     []string{"-r", "cpu=4,memory=16"},
+			expected: map[string]int{"cpu": 4, "memory": 16},
+		}),
+		Entry(nil, &mapTE{
+			given:   "key not in allow-list",
+			args:    []string{"--limits", "disk=4"},
+			invalid: true,
+		}),
+		Entry(nil, &mapTE{
+			given:   "value out of range",
+			args:    []string{"--limits", "cpu=100"},
+			invalid: true,
+		}),
+		Entry(nil, &mapTE{
+			given:   "value of wrong type",
+			args:    []string{"--limits", "cpu=many"},
+			invalid: true,
+		}),
+		Entry(nil, &mapTE{
+			given:   "entry without value",
+			args:    []string{"--limits", "cpu"},
+			invalid: true,
+		}),
+	)
+
+	It("🧪 should: identify entry failing value validation", func() {
+		_, err := lab.ExecuteCommand(rootCommand, "--limits", "cpu=100")
+
+		_, ok := err.(locale.WithinOptValidationBehaviourQuery)
+		Expect(ok).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("limits[cpu]")))
+	})
+
+	It("🧪 should: reject key not matching pattern", func() {
+		_, err := lab.ExecuteCommand(rootCommand, "--labels", "Tier=gold")
+
+		_, ok := err.(locale.MatchOptValidationBehaviourQuery)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("🧪 should: replace default", func() {
+		_, err := lab.ExecuteCommand(rootCommand, "--labels", "region=eu")
+
+		Expect(err).To(Succeed())
+		Expect(paramSet.Native.Labels).To(Equal(map[string]string{"region": "eu"}))
+		Expect(rootCommand.Flags().Lookup("labels").DefValue).To(Equal("tier=bronze"))
+	})
+
+	It("🧪 should: parse quoted entry containing comma", func() {
+		_, err := lab.ExecuteCommand(rootCommand, "--labels", `"region=eu,us",tier=gold`)
+
+		Expect(err).To(Succeed())
+		Expect(paramSet.Native.Labels).To(Equal(map[string]string{"region": "eu,us", "tier": "gold"}))
+		Expect(rootCommand.Flags().Lookup("labels").Value.String()).To(Equal(`"region=eu,us",tier=gold`))
+	})
+
+	It("🧪 should: parse typed values", func() {
+		_, err := lab.ExecuteCommand(rootCommand, "--timeouts", "build=5m,test=90s")
+
+		Expect(err).To(Succeed())
+		Expect(paramSet.Native.Timeouts["test"]).To(Equal(90 * time.Second))
+	})
+
+	Context("MergeMap", func() {
+		It("🧪 should: give precedence to command line entries", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--limits", "cpu=4")
+			Expect(err).To(Succeed())
+
+			Expect(assistant.MergeMap(&paramSet.Native.Limits,
+				map[string]any{"cpu": 8, "memory": "32"},
+				rootCommand.Flags().Lookup("limits"),
+			)).To(Succeed())
+			Expect(paramSet.Native.Limits).To(Equal(map[string]int{"cpu": 4, "memory": 32}))
+		})
+
+		It("🧪 should: give precedence to config over defaults", func() {
+			_, err := lab.ExecuteCommand(rootCommand)
+			Expect(err).To(Succeed())
+
+			Expect(assistant.MergeMap(&paramSet.Native.Labels,
+				map[string]string{"tier": "gold"},
+				rootCommand.Flags().Lookup("labels"),
+			)).To(Succeed())
+			Expect(paramSet.Native.Labels).To(Equal(map[string]string{"tier": "gold"}))
+		})
+
+		It("🧪 should: reject invalid config value", func() {
+			err := assistant.MergeMap(&paramSet.Native.Limits,
+				map[string]any{"cpu": "lots"}, rootCommand.Flags().Lookup("limits"),
+			)
+
+			_, ok := err.(locale.InvalidMapEntryBehaviourQuery)
+			Expect(ok).To(BeTrue())
+
+			structured, ok := err.(locale.StructuredValidationError)
+			Expect(ok).To(BeTrue())
+			Expect(structured.Failure().Flag).To(Equal("limits"))
+		})
+	})
+})
@@ -0,0 +1,400 @@
+package assistant
+
+import (
+	"cmp"
+	"math"
+	"math/bits"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+)
+
+// quantityUnit is a unit of measure, defined by its multiple of the base unit.
+type quantityUnit struct {
+	name string
+	size float64
+}
+
+// byteSizeUnits are the SI (decimal) and IEC (binary) byte size units, in
+// descending order of size.
+var byteSizeUnits = []quantityUnit{
+	{"EiB", 1 << 60}, {"EB", 1e18},
+	{"PiB", 1 << 50}, {"PB", 1e15},
+	{"TiB", 1 << 40}, {"TB", 1e12},
+	{"GiB", 1 << 30}, {"GB", 1e9},
+	{"MiB", 1 << 20}, {"MB", 1e6},
+	{"KiB", 1 << 10}, {"kB", 1e3},
+}
+
+var (
+	byteSizeRx = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*([a-zA-Z]*)$`)
+	rateRx     = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*/\s*([a-z]+)$`)
+	percentRx  = regexp.MustCompile(`^(-?\d+(?:\.\d+)?)\s*%?$`)
+)
+
+// rateUnits are the time units of a rate, in ascending order of duration
+var rateUnits = []quantityUnit{
+	{"s", 1}, {"m", 60}, {"h", 3600}, {"d", 86400},
+}
+
+const (
+	byteSizeExample = "1.5GiB"
+	rateExample     = "10/s"
+	percentExample  = "75%"
+)
+
+// formatDecimal formats the number with at most 2 decimal places, without
+// trailing zeros.
+func formatDecimal(number float64) string {
+	formatted := strconv.FormatFloat(number, 'f', 2, 64)
+	formatted = strings.TrimRight(formatted, "0")
+
+	return strings.TrimSuffix(formatted, ".")
+}
+
+func lookupByteSizeUnit(suffix string) (uint64, bool) {
+	switch name := strings.ToLower(strings.TrimSuffix(strings.TrimSuffix(suffix, "B"), "b")); name {
+	case "":
+		return 1, true
+	case "i":
+		return 0, false
+	default:
+		binary := strings.HasSuffix(name, "i")
+		prefix := strings.TrimSuffix(name, "i")
+		power := strings.Index("kmgtpe", prefix) + 1
+
+		if len(prefix) != 1 || power == 0 {
+			return 0, false
+		}
+
+		if binary {
+			return 1 << (10 * power), true
+		}
+
+		return uint64(math.Pow10(3 * power)), true
+	}
+}
+
+// ParseByteSize parses a byte size, being a number optionally followed by
+// an SI unit (kB, MB, GB, TB, PB, EB; multiples of 1000) or an IEC unit
+// (KiB, MiB, GiB, TiB, PiB, EiB; multiples of 1024), eg "1.5GiB", "10MB".
+// Units are case insensitive and the trailing B is optional, eg "512k". A
+// fractional size that is not a whole number of bytes is rounded to the
+// nearest byte, eg "1.0005kB" => 1001.
+func ParseByteSize(s string) (uint64, error) {
+	match := byteSizeRx.FindStringSubmatch(strings.TrimSpace(s))
+	if match == nil {
+		return 0, locale.NewInvalidQuantityValueValidationError(s, byteSizeExample)
+	}
+
+	unit, found := lookupByteSizeUnit(match[2])
+	if !found {
+		return 0, locale.NewInvalidQuantityValueValidationError(s, byteSizeExample)
+	}
+
+	if whole, err := strconv.ParseUint(match[1], 10, 64); err == nil {
+		hi, size := bits.Mul64(whole, unit)
+		if hi != 0 {
+			return 0, locale.NewInvalidQuantityValueValidationError(s, byteSizeExample)
+		}
+
+		return size, nil
+	}
+
+	number, _ := strconv.ParseFloat(match[1], 64)
+	size := math.Round(number * float64(unit))
+
+	if size >= math.MaxUint64 {
+		return 0, locale.NewInvalidQuantityValueValidationError(s, byteSizeExample)
+	}
+
+	return uint64(size), nil
+}
+
+// FormatByteSize formats the byte size in the unit, SI or IEC, that yields
+// the shortest exact representation with at most 2 decimal places, eg
+// 1610612736 => "1.5GiB", 64000 => "64kB", falling back to the largest IEC
+// unit if there is no such unit.
+func FormatByteSize(size uint64) string {
+	shortest := ""
+
+	for _, unit := range byteSizeUnits {
+		if float64(size) < unit.size {
+			continue
+		}
+
+		scaled := float64(size) / unit.size * 100
+
+		if scaled == math.Round(scaled) {
+			formatted := formatDecimal(float64(size)/unit.size) + unit.name
+
+			if shortest == "" || len(formatted) < len(shortest) {
+				shortest = formatted
+			}
+		}
+	}
+
+	if shortest != "" {
+		return shortest
+	}
+
+	for _, unit := range byteSizeUnits {
+		if strings.HasSuffix(unit.name, "iB") && float64(size) >= unit.size {
+			return formatDecimal(float64(size)/unit.size) + unit.name
+		}
+	}
+
+	return strconv.FormatUint(size, 10) + "B"
+}
+
+// ByteSizeCodec is the ValueCodec for byte sizes (see ParseByteSize).
+var ByteSizeCodec = &ValueCodec[uint64]{
+	Parse:  ParseByteSize,
+	Format: FormatByteSize,
+	Type:   "size",
+}
+
+// ParseRate parses a rate, being a number of occurrences per unit of time,
+// where the unit is one of s, m, h or d, eg "10/s", "600/m", into
+// occurrences per second.
+func ParseRate(s string) (float64, error) {
+	match := rateRx.FindStringSubmatch(strings.TrimSpace(s))
+	if match == nil {
+		return 0, locale.NewInvalidQuantityValueValidationError(s, rateExample)
+	}
+
+	for _, unit := range rateUnits {
+		if unit.name == match[2] {
+			number, _ := strconv.ParseFloat(match[1], 64)
+
+			return number / unit.size, nil
+		}
+	}
+
+	return 0, locale.NewInvalidQuantityValueValidationError(s, rateExample)
+}
+
+// FormatRate formats a rate in occurrences per second, using the shortest
+// unit of time in which at least 1 occurrence occurs, eg 0.5 => "30/m".
+func FormatRate(rate float64) string {
+	for _, unit := range rateUnits {
+		if rate*unit.size >= 1 {
+			return formatDecimal(rate*unit.size) + "/" + unit.name
+		}
+	}
+
+	last := rateUnits[len(rateUnits)-1]
+
+	if rate == 0 {
+		last = rateUnits[0]
+	}
+
+	return formatDecimal(rate*last.size) + "/" + last.name
+}
+
+// RateCodec is the ValueCodec for rates (see ParseRate).
+var RateCodec = &ValueCodec[float64]{
+	Parse:  ParseRate,
+	Format: FormatRate,
+	Type:   "rate",
+}
+
+// ParsePercent parses a percentage, with an optional % suffix, eg "75%",
+// into a ratio, eg 0.75.
+func ParsePercent(s string) (float64, error) {
+	match := percentRx.FindStringSubmatch(strings.TrimSpace(s))
+	if match == nil {
+		return 0, locale.NewInvalidQuantityValueValidationError(s, percentExample)
+	}
+
+	number, _ := strconv.ParseFloat(match[1], 64)
+
+	return number / 100, nil
+}
+
+// FormatPercent formats a ratio as a percentage, eg 0.75 => "75%".
+func FormatPercent(ratio float64) string {
+	return formatDecimal(ratio*100) + "%"
+}
+
+// PercentCodec is the ValueCodec for percentages (see ParsePercent).
+var PercentCodec = &ValueCodec[float64]{
+	Parse:  ParsePercent,
+	Format: FormatPercent,
+	Type:   "percent",
+}
+
+func mustParseBound[T any](info *FlagInfo, codec *ValueCodec[T], bound string) T {
+	value, err := codec.Parse(bound)
+	if err != nil {
+		panic(locale.NewInvalidQuantityBoundNativeError(info.FlagName(), bound, err))
+	}
+
+	return value
+}
+
+func bindQuantityWithin[N any, T cmp.Ordered](params *ParamSet[N], info *FlagInfo, to *T,
+	codec *ValueCodec[T], low, high string,
+) OptionValidator {
+	lower, upper := mustParseBound(info, codec, low), mustParseBound(info, codec, high)
+
+	return bindValidatedValue(params, info, to, codec, func(value T, flag *pflag.Flag) error {
+		if !flag.Changed {
+			return nil
+		}
+		if value >= lower && value <= upper {
+			return nil
+		}
+
+		return locale.NewWithinOptValidationError(info.FlagName(),
+			codec.Format(value), codec.Format(lower), codec.Format(upper),
+		)
+	}, rangeConstraint(ConstraintWithin, lower, upper))
+}
+
+func bindQuantityAtLeast[N any, T cmp.Ordered](params *ParamSet[N], info *FlagInfo, to *T,
+	codec *ValueCodec[T], threshold string,
+) OptionValidator {
+	bound := mustParseBound(info, codec, threshold)
+
+	return bindValidatedValue(params, info, to, codec, func(value T, flag *pflag.Flag) error {
+		if !flag.Changed {
+			return nil
+		}
+		if value >= bound {
+			return nil
+		}
+
+		return locale.NewAtLeastOptValidationError(info.FlagName(),
+			codec.Format(value), codec.Format(bound),
+		)
+	}, thresholdConstraint(ConstraintAtLeast, bound))
+}
+
+func bindQuantityAtMost[N any, T cmp.Ordered](params *ParamSet[N], info *FlagInfo, to *T,
+	codec *ValueCodec[T], threshold string,
+) OptionValidator {
+	bound := mustParseBound(info, codec, threshold)
+
+	return bindValidatedValue(params, info, to, codec, func(value T, flag *pflag.Flag) error {
+		if !flag.Changed {
+			return nil
+		}
+		if value <= bound {
+			return nil
+		}
+
+		return locale.NewAtMostOptValidationError(info.FlagName(),
+			codec.Format(value), codec.Format(bound),
+		)
+	}, thresholdConstraint(ConstraintAtMost, bound))
+}
+
+// BindByteSize binds a byte size flag (see ParseByteSize) with a shorthand if
+// 'info.Short' has been set otherwise binds without a short name. If set,
+// 'info.Default' must be a uint64.
+func (params *ParamSet[N]) BindByteSize(info *FlagInfo, to *uint64) *ParamSet[N] {
+	return BindValue(params, info, to, ByteSizeCodec)
+}
+
+// BindValidatedByteSize binds a byte size flag with a shorthand if 'info.Short'
+// has been set otherwise binds without a short name. Client can provide a
+// function to validate option values.
+func (params *ParamSet[N]) BindValidatedByteSize(info *FlagInfo, to *uint64,
+	validator ValueValidatorFn[uint64],
+) OptionValidator {
+	return BindValidatedValue(params, info, to, ByteSizeCodec, validator)
+}
+
+// BindValidatedByteSizeWithin binds a byte size flag, which fails validation
+// if the option value does not lie within 'low' and 'high' (inclusive), which
+// are specified with units, eg "1KiB", "1GiB".
+func (params *ParamSet[N]) BindValidatedByteSizeWithin(info *FlagInfo, to *uint64, low, high string) OptionValidator {
+	return bindQuantityWithin(params, info, to, ByteSizeCodec, low, high)
+}
+
+// BindValidatedByteSizeAtLeast binds a byte size flag, which fails validation
+// if the option value is less than 'threshold', which is specified with units.
+func (params *ParamSet[N]) BindValidatedByteSizeAtLeast(info *FlagInfo, to *uint64, threshold string) OptionValidator {
+	return bindQuantityAtLeast(params, info, to, ByteSizeCodec, threshold)
+}
+
+// BindValidatedByteSizeAtMost binds a byte size flag, which fails validation
+// if the option value is greater than 'threshold', which is specified with units.
+func (params *ParamSet[N]) BindValidatedByteSizeAtMost(info *FlagInfo, to *uint64, threshold string) OptionValidator {
+	return bindQuantityAtMost(params, info, to, ByteSizeCodec, threshold)
+}
+
+// BindRate binds a rate flag (see ParseRate), stored as occurrences per
+// second, with a shorthand if 'info.Short' has been set otherwise binds
+// without a short name. If set, 'info.Default' must be a float64.
+func (params *ParamSet[N]) BindRate(info *FlagInfo, to *float64) *ParamSet[N] {
+	return BindValue(params, info, to, RateCodec)
+}
+
+// BindValidatedRate binds a rate flag with a shorthand if 'info.Short' has
+// been set otherwise binds without a short name. Client can provide a
+// function to validate option values.
+func (params *ParamSet[N]) BindValidatedRate(info *FlagInfo, to *float64,
+	validator ValueValidatorFn[float64],
+) OptionValidator {
+	return BindValidatedValue(params, info, to, RateCodec, validator)
+}
+
+// BindValidatedRateWithin binds a rate flag, which fails validation if the
+// option value does not lie within 'low' and 'high' (inclusive), which are
+// specified with units, eg "1/m", "10/s".
+func (params *ParamSet[N]) BindValidatedRateWithin(info *FlagInfo, to *float64, low, high string) OptionValidator {
+	return bindQuantityWithin(params, info, to, RateCodec, low, high)
+}
+
+// BindValidatedRateAtLeast binds a rate flag, which fails validation if the
+// option value is less than 'threshold', which is specified with units.
+func (params *ParamSet[N]) BindValidatedRateAtLeast(info *FlagInfo, to *float64, threshold string) OptionValidator {
+	return bindQuantityAtLeast(params, info, to, RateCodec, threshold)
+}
+
+// BindValidatedRateAtMost binds a rate flag, which fails validation if the
+// option value is greater than 'threshold', which is specified with units.
+func (params *ParamSet[N]) BindValidatedRateAtMost(info *FlagInfo, to *float64, threshold string) OptionValidator {
+	return bindQuantityAtMost(params, info, to, RateCodec, threshold)
+}
+
+// BindPercent binds a percentage flag (see ParsePercent), stored as a ratio,
+// with a shorthand if 'info.Short' has been set otherwise binds without a
+// short name. If set, 'info.Default' must be a float64 ratio, eg 0.5 for 50%.
+func (params *ParamSet[N]) BindPercent(info *FlagInfo, to *float64) *ParamSet[N] {
+	return BindValue(params, info, to, PercentCodec)
+}
+
+// BindValidatedPercent binds a percentage flag with a shorthand if 'info.Short'
+// has been set otherwise binds without a short name. Client can provide a
+// function to validate option values.
+func (params *ParamSet[N]) BindValidatedPercent(info *FlagInfo, to *float64,
+	validator ValueValidatorFn[float64],
+) OptionValidator {
+	return BindValidatedValue(params, info, to, PercentCodec, validator)
+}
+
+// BindValidatedPercentWithin binds a percentage flag, which fails validation
+// if the option value does not lie within 'low' and 'high' (inclusive), which
+// are specified as percentages, eg "0%", "100%".
+func (params *ParamSet[N]) BindValidatedPercentWithin(info *FlagInfo, to *float64, low, high string) OptionValidator {
+	return bindQuantityWithin(params, info, to, PercentCodec, low, high)
+}
+
+// BindValidatedPercentAtLeast binds a percentage flag, which fails validation
+// if the option value is less than 'threshold', which is specified as a percentage.
+func (params *ParamSet[N]) BindValidatedPercentAtLeast(info *FlagInfo, to *float64, threshold string) OptionValidator {
+	return bindQuantityAtLeast(params, info, to, PercentCodec, threshold)
+}
+
+// BindValidatedPercentAtMost binds a percentage flag, which fails validation
+// if the option value is greater than 'threshold', which is specified as a percentage.
+func (params *ParamSet[N]) BindValidatedPercentAtMost(info *FlagInfo, to *float64, threshold string) OptionValidator {
+	return bindQuantityAtMost(params, info, to, PercentCodec, threshold)
+}
@@ -0,0 +1,222 @@
+package assistant_test
+
+import (
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant"
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/internal/lab"
+	"github.com/snivilised/li18ngo"
+)
+
+type QuantityParameterSet struct {
+	MaxSize uint64
+	Rate    float64
+	Ratio   float64
+}
+
+type byteSizeTE struct {
+	value     string
+	expected  uint64
+	formatted string
+	invalid   bool
+}
+
+type quantityTE struct {
+	given    string
+	should   string
+	args     []string
+	expected string
+}
+
+var _ = Describe("Quantities", Ordered, func() {
+	var (
+		rootCommand *cobra.Command
+		paramSet    *assistant.ParamSet[QuantityParameterSet]
+	)
+
+	BeforeAll(func() {
+		if err := li18ngo.Use(func(o *li18ngo.UseOptions) {
+			o.From = li18ngo.LoadFrom{
+				Path: lab.Path(lab.Repo("../.."), "Test/data/l10n"),
+				Sources: li18ngo.TranslationFiles{
+					locale.CobrassSourceID: li18ngo.TranslationSource{Name: "test"},
+				},
+			}
+		}); err != nil {
+			Fail(err.Error())
+		}
+	})
+
+	DescribeTable("ParseByteSize",
+		func(entry *byteSizeTE) {
+			size, err := assistant.ParseByteSize(entry.value)
+
+			if entry.invalid {
+				Expect(err).To(HaveOccurred())
+				_, ok := err.(locale.InvalidQuantityValueBehaviourQuery)
+				Expect(ok).To(BeTrue())
+
+				return
+			}
+
+			Expect(err).To(Succeed())
+			Expect(size).To(Equal(entry.expected))
+			Expect(assistant.FormatByteSize(size)).To(Equal(entry.formatted))
+		},
+		func(entry *byteSizeTE) string {
+			return "🧪 --> given: '" + entry.value + "', should: parse as byte size"
+		},
+
+		Entry(nil, &byteSizeTE{value: "512", expected: 512, formatted: "512B"}),
+		Entry(nil, &byteSizeTE{value: "1.5GiB", expected: 1610612736, formatted: "1.5GiB"}),
+		Entry(nil, &byteSizeTE{value: "1.5GB", expected: 1500000000, formatted: "1.5GB"}),
+		Entry(nil, &byteSizeTE{value: "10 MB", expected: 10000000, formatted: "10MB"}),
+		Entry(nil, &byteSizeTE{value: "64k", expected: 64000, formatted: "64kB"}),
+		Entry(nil, &byteSizeTE{value: "4kib", expected: 4096, formatted: "4KiB"}),
+		Entry(nil, &byteSizeTE{value: "1234567", expected: 1234567, formatted: "1.18MiB"}),
+		Entry(nil, &byteSizeTE{value: "1.0005kB", expected: 1001, formatted: "1001B"}),
+		Entry(nil, &byteSizeTE{value: "lots", invalid: true}),
+		Entry(nil, &byteSizeTE{value: "10XB", invalid: true}),
+		Entry(nil, &byteSizeTE{value: "100EiB", invalid: true}),
+	)
+
+	Context("ParseRate", func() {
+		It("🧪 should: parse rate per unit of time", func() {
+			Expect(assistant.ParseRate("10/s")).To(Equal(10.0))
+			Expect(assistant.ParseRate("600/m")).To(Equal(10.0))
+			Expect(assistant.ParseRate("1800/h")).To(Equal(0.5))
+			Expect(assistant.FormatRate(0.5)).To(Equal("30/m"))
+			Expect(assistant.FormatRate(10)).To(Equal("10/s"))
+
+			_, err := assistant.ParseRate("10/y")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("ParsePercent", func() {
+		It("🧪 should: parse percentage as ratio", func() {
+			Expect(assistant.ParsePercent("75%")).To(Equal(0.75))
+			Expect(assistant.ParsePercent("12.5")).To(Equal(0.125))
+			Expect(assistant.FormatPercent(0.75)).To(Equal("75%"))
+
+			_, err := assistant.ParsePercent("most")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("binders", func() {
+		BeforeEach(func() {
+			rootCommand = &cobra.Command{
+				Use:          "poke",
+				SilenceUsage: true,
+				RunE: func(_ *cobra.Command, _ []string) error {
+					return paramSet.Validate()
+				},
+			}
+			paramSet = assistant.NewParamSet[QuantityParameterSet](rootCommand)
+			paramSet.BindValidatedByteSizeWithin(
+				assistant.NewFlagInfo("max-size maximum file size", "m", uint64(1<<20)),
+				&paramSet.Native.MaxSize, "1KiB", "1GiB",
+			)
+			paramSet.BindValidatedRateAtMost(
+				assistant.NewFlagInfo("rate of requests", "r", 1.0),
+				&paramSet.Native.Rate, "100/s",
+			)
+			paramSet.BindValidatedPercentAtLeast(
+				assistant.NewFlagInfo("ratio of successes", "", 0.5),
+				&paramSet.Native.Ratio, "10%",
+			)
+		})
+
+		It("🧪 should: parse values into native fields", func() {
+			_, err := lab.ExecuteCommand(rootCommand,
+				"--max-size", "1.5MiB", "--rate", "10/s", "--ratio", "75%",
+			)
+
+			Expect(err).To(Succeed())
+			Expect(paramSet.Native.MaxSize).To(Equal(uint64(1572864)))
+			Expect(paramSet.Native.Rate).To(Equal(10.0))
+			Expect(paramSet.Native.Ratio).To(Equal(0.75))
+		})
+
+		It("🧪 should: show defaults in human units", func() {
+			Expect(rootCommand.Flags().Lookup("max-size").DefValue).To(Equal("1MiB"))
+			Expect(rootCommand.Flags().Lookup("rate").DefValue).To(Equal("1/s"))
+			Expect(rootCommand.Flags().Lookup("ratio").DefValue).To(Equal("50%"))
+		})
+
+		DescribeTable("validation failure",
+			func(entry *quantityTE) {
+				_, err := lab.ExecuteCommand(rootCommand, entry.args...)
+
+				Expect(err).To(MatchError(ContainSubstring(entry.expected)))
+			},
+			func(entry *quantityTE) string {
+				return "🧪 --> given: " + entry.given + ", should: " + entry.should
+			},
+
+			Entry(nil, &quantityTE{
+				given:    "byte size out of range",
+				should:   "report values in human units",
+				args:     []string{"--max-size", "2GiB"},
+				expected: "'2GiB', out of range: [1KiB]..[1GiB]",
+			}),
+
+			Entry(nil, &quantityTE{
+				given:    "rate too high",
+				should:   "report values in human units",
+				args:     []string{"--rate", "12000/m"},
+				expected: "'200/s'",
+			}),
+
+			Entry(nil, &quantityTE{
+				given:    "ratio too low",
+				should:   "report values in human units",
+				args:     []string{"--ratio", "5%"},
+				expected: "'5%'",
+			}),
+		)
+
+		It("🧪 should: panic given invalid bound", func() {
+			Expect(func() {
+				paramSet.BindValidatedByteSizeAtMost(
+					assistant.NewFlagInfo("min-size minimum file size", "", uint64(0)),
+					&paramSet.Native.MaxSize, "huge",
+				)
+			}).To(Panic())
+		})
+	})
+
+	Context("given: bounds changed between manifests", func() {
+		manifest := func(low, high string) *assistant.Manifest {
+			command := &cobra.Command{Use: "poke"}
+			container := assistant.NewCobraContainer(command)
+			ps := assistant.NewParamSet[QuantityParameterSet](command)
+			ps.BindValidatedByteSizeWithin(
+				assistant.NewFlagInfo("max-size maximum file size", "m", uint64(1<<20)),
+				&ps.Native.MaxSize, low, high,
+			)
+			container.MustRegisterParamSet("quantity-ps", ps)
+
+			data, _ := container.Manifest().Marshal()
+			result, _ := assistant.UnmarshalManifest(data)
+
+			return result
+		}
+
+		It("🧪 should: compare bounds by quantity", func() {
+			Expect(assistant.CheckCompatibility(
+				manifest("1KiB", "900MiB"), manifest("1KiB", "1GiB"),
+			)).To(BeEmpty())
+
+			changes := assistant.CheckCompatibility(
+				manifest("1KiB", "1GiB"), manifest("1KiB", "900MiB"),
+			)
+			Expect(changes).To(HaveLen(1))
+			Expect(changes[0].Kind).To(Equal(assistant.ValidatorNarrowedChange))
+		})
+	})
+})
@@ -0,0 +1,263 @@
+package assistant
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/internal/third/lo"
+)
+
+// RangeBound defines the types that can be the bounds of a Range, which
+// includes time.Duration.
+type RangeBound interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Range represents a range of values, bound by Low and High (inclusive,
+// unless ExcludeHigh is set). A range may be open ended, in which case it
+// is unbounded on the side whose bound is missing.
+type Range[T RangeBound] struct {
+	// Low is the lower bound, only applicable if HasLow is set
+	//
+	Low T
+
+	// High is the upper bound, only applicable if HasHigh is set
+	//
+	High T
+
+	// HasLow indicates that the range has a lower bound
+	//
+	HasLow bool
+
+	// HasHigh indicates that the range has an upper bound
+	//
+	HasHigh bool
+
+	// ExcludeHigh indicates that the upper bound is exclusive
+	//
+	ExcludeHigh bool
+}
+
+// NewRange creates a closed Range, inclusive of both low and high.
+func NewRange[T RangeBound](low, high T) Range[T] {
+	return Range[T]{
+		Low:     low,
+		High:    high,
+		HasLow:  true,
+		HasHigh: true,
+	}
+}
+
+// Contains determines whether the value lies within the range.
+func (r Range[T]) Contains(value T) bool {
+	if r.HasLow && value < r.Low {
+		return false
+	}
+
+	if r.HasHigh && (value > r.High || (r.ExcludeHigh && value == r.High)) {
+		return false
+	}
+
+	return true
+}
+
+// ContainsRange determines whether the other range lies entirely within
+// this range.
+func (r Range[T]) ContainsRange(other Range[T]) bool {
+	if r.HasLow && (!other.HasLow || other.Low < r.Low) {
+		return false
+	}
+
+	if r.HasHigh {
+		if !other.HasHigh || other.High > r.High {
+			return false
+		}
+
+		if r.ExcludeHigh && !other.ExcludeHigh && other.High == r.High {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsZero determines whether the range has not been defined.
+func (r Range[T]) IsZero() bool {
+	return !r.HasLow && !r.HasHigh
+}
+
+// String formats the range in the form accepted by ParseRange, eg "2..5",
+// "2..<5", "2.." or "..5".
+func (r Range[T]) String() string {
+	if r.IsZero() {
+		return ""
+	}
+
+	builder := strings.Builder{}
+
+	if r.HasLow {
+		builder.WriteString(fmt.Sprint(r.Low))
+	}
+
+	builder.WriteString("..")
+
+	if r.HasHigh {
+		if r.ExcludeHigh {
+			builder.WriteString("<")
+		}
+
+		builder.WriteString(fmt.Sprint(r.High))
+	}
+
+	return builder.String()
+}
+
+// splitRange splits the range into its low and high parts. The separator is
+// either "..", "..<" or a "-" that follows the first character, eg
+// "8000-8100", which can't be used with open ranges, so "5-" is not split
+// and is subsequently rejected as an invalid bound.
+func splitRange(s string) (low, high string, exclude, found bool) {
+	if i := strings.Index(s, ".."); i >= 0 {
+		high = s[i+2:]
+		exclude = strings.HasPrefix(high, "<")
+
+		return s[:i], strings.TrimPrefix(high, "<"), exclude, true
+	}
+
+	if i := strings.Index(s[min(1, len(s)):], "-"); i >= 0 && i+2 < len(s) {
+		i++
+
+		return s[:i], s[i+1:], false, true
+	}
+
+	return s, s, false, false
+}
+
+// ParseRange parses a range of the form "low..high" (inclusive),
+// "low..<high" (exclusive high), "low-high" (inclusive) or a single value,
+// eg "5", which is the range "5..5". Either bound of the ".." forms can be
+// omitted to create an open ended range, eg "2.." or "..5". Bounds are
+// parsed according to T, so durations are of the form "1m30s".
+func ParseRange[T RangeBound](s string) (Range[T], error) {
+	var (
+		result  Range[T]
+		example = lo.Ternary(reflect.TypeFor[T]() == durationType, "1s..5m", "2..5")
+	)
+
+	value := strings.TrimSpace(s)
+	low, high, exclude, found := splitRange(value)
+	low, high = strings.TrimSpace(low), strings.TrimSpace(high)
+
+	if value == "" || (found && low == "" && high == "") {
+		return result, locale.NewInvalidRangeValueValidationError(s, example)
+	}
+
+	result.ExcludeHigh = exclude
+
+	if low != "" {
+		bound, err := parseScalar[T](low)
+		if err != nil {
+			return result, locale.NewInvalidRangeValueValidationError(s, example)
+		}
+
+		result.Low, result.HasLow = bound, true
+	}
+
+	if high != "" {
+		bound, err := parseScalar[T](high)
+		if err != nil {
+			return result, locale.NewInvalidRangeValueValidationError(s, example)
+		}
+
+		result.High, result.HasHigh = bound, true
+	}
+
+	if result.HasLow && result.HasHigh &&
+		(result.Low > result.High || (result.ExcludeHigh && result.Low == result.High)) {
+		return result, locale.NewInvalidRangeValueValidationError(s, example)
+	}
+
+	return result, nil
+}
+
+// RangeCodec creates the ValueCodec for a Range[T] (see ParseRange).
+func RangeCodec[T RangeBound]() *ValueCodec[Range[T]] {
+	return &ValueCodec[Range[T]]{
+		Parse: ParseRange[T],
+		Format: func(r Range[T]) string {
+			return r.String()
+		},
+		Type: "range",
+	}
+}
+
+// BindRange binds a range flag, eg "--depth 2..5" (see ParseRange), with a
+// shorthand if 'info.Short' has been set otherwise binds without a short
+// name. If set, 'info.Default' must be a Range[T]. A range whose low bound
+// is greater than its high bound is rejected when the flag is parsed.
+func BindRange[N any, T RangeBound](params *ParamSet[N], info *FlagInfo, to *Range[T]) *ParamSet[N] {
+	return BindValue(params, info, to, RangeCodec[T]())
+}
+
+// BindValidatedRange binds a range flag (see BindRange). Client can provide
+// a function to validate the range.
+func BindValidatedRange[N any, T RangeBound](params *ParamSet[N], info *FlagInfo, to *Range[T],
+	validator ValueValidatorFn[Range[T]],
+) OptionValidator {
+	return BindValidatedValue(params, info, to, RangeCodec[T](), validator)
+}
+
+// BindValidatedRangeWithin binds a range flag (see BindRange), which fails
+// validation if the range does not lie entirely within 'bounds', so an open
+// ended range is only valid if 'bounds' is open ended on the same side.
+func BindValidatedRangeWithin[N any, T RangeBound](params *ParamSet[N], info *FlagInfo, to *Range[T],
+	bounds Range[T],
+) OptionValidator {
+	return bindValidatedValue(params, info, to, RangeCodec[T](), func(value Range[T], flag *pflag.Flag) error {
+		if !flag.Changed {
+			return nil
+		}
+		if bounds.ContainsRange(value) {
+			return nil
+		}
+
+		return locale.NewWithinOptValidationError(info.FlagName(),
+			value.String(), boundString(bounds.Low, bounds.HasLow), boundString(bounds.High, bounds.HasHigh),
+		)
+	}, boundsConstraint(bounds))
+}
+
+// boundsConstraint creates the constraint of the bounds, in which an open
+// bound is omitted and an exclusive high bound is indicated by the
+// "excludeHigh" param.
+func boundsConstraint[T RangeBound](bounds Range[T]) *ValidatorConstraint {
+	constraint := rangeConstraint(ConstraintWithin, bounds.Low, bounds.High)
+
+	if !bounds.HasLow {
+		delete(constraint.Params, "low")
+	}
+
+	if !bounds.HasHigh {
+		delete(constraint.Params, "high")
+	}
+
+	if bounds.HasHigh && bounds.ExcludeHigh {
+		constraint.Params["excludeHigh"] = true
+	}
+
+	return constraint
+}
+
+func boundString[T RangeBound](bound T, has bool) string {
+	if !has {
+		return ""
+	}
+
+	return fmt.Sprint(bound)
+}
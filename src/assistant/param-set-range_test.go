@@ -0,0 +1,211 @@
+package assistant_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant"
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/internal/lab"
+	"github.com/snivilised/li18ngo"
+)
+
+type RangeParameterSet struct {
+	Depth   assistant.Range[int]
+	Ports   assistant.Range[uint16]
+	Timeout assistant.Range[time.Duration]
+}
+
+type rangeTE struct {
+	value    string
+	expected assistant.Range[int]
+	invalid  bool
+}
+
+var _ = Describe("Range", Ordered, func() {
+	var (
+		rootCommand *cobra.Command
+		paramSet    *assistant.ParamSet[RangeParameterSet]
+	)
+
+	BeforeAll(func() {
+		if err := li18ngo.Use(func(o *li18ngo.UseOptions) {
+			o.From = li18ngo.LoadFrom{
+				Path: lab.Path(lab.Repo("../.."), "Test/data/l10n"),
+				Sources: li18ngo.TranslationFiles{
+					locale.CobrassSourceID: li18ngo.TranslationSource{Name: "test"},
+				},
+			}
+		}); err != nil {
+			Fail(err.Error())
+		}
+	})
+
+	DescribeTable("ParseRange",
+		func(entry *rangeTE) {
+			result, err := assistant.ParseRange[int](entry.value)
+
+			if entry.invalid {
+				Expect(err).To(HaveOccurred())
+				_, ok := err.(locale.InvalidRangeValueBehaviourQuery)
+				Expect(ok).To(BeTrue())
+
+				return
+			}
+
+			Expect(err).To(Succeed())
+			Expect(result).To(Equal(entry.expected))
+		},
+		func(entry *rangeTE) string {
+			return "🧪 --> given: '" + entry.value + "', should: parse range"
+		},
+
+		Entry(nil, &rangeTE{value: "2..5", expected: assistant.NewRange(2, 5)}),
+		Entry(nil, &rangeTE{value: "8000-8100", expected: assistant.NewRange(8000, 8100)}),
+		Entry(nil, &rangeTE{value: "-5--2", expected: assistant.NewRange(-5, -2)}),
+		Entry(nil, &rangeTE{value: "3", expected: assistant.NewRange(3, 3)}),
+		Entry(nil, &rangeTE{value: "2..", expected: assistant.Range[int]{Low: 2, HasLow: true}}),
+		Entry(nil, &rangeTE{value: "..5", expected: assistant.Range[int]{High: 5, HasHigh: true}}),
+		Entry(nil, &rangeTE{value: "2..<5", expected: assistant.Range[int]{
+			Low: 2, High: 5, HasLow: true, HasHigh: true, ExcludeHigh: true,
+		}}),
+		Entry(nil, &rangeTE{value: "5..2", invalid: true}),
+		Entry(nil, &rangeTE{value: "2..<2", invalid: true}),
+		Entry(nil, &rangeTE{value: "..", invalid: true}),
+		Entry(nil, &rangeTE{value: "5-", invalid: true}),
+		Entry(nil, &rangeTE{value: "a..b", invalid: true}),
+	)
+
+	Context("Contains", func() {
+		It("🧪 should: determine membership", func() {
+			closed := assistant.NewRange(2, 5)
+			Expect(closed.Contains(2)).To(BeTrue())
+			Expect(closed.Contains(5)).To(BeTrue())
+			Expect(closed.Contains(6)).To(BeFalse())
+
+			exclusive, _ := assistant.ParseRange[int]("2..<5")
+			Expect(exclusive.Contains(5)).To(BeFalse())
+
+			open, _ := assistant.ParseRange[int]("2..")
+			Expect(open.Contains(1000)).To(BeTrue())
+			Expect(closed.ContainsRange(open)).To(BeFalse())
+			Expect(open.ContainsRange(closed)).To(BeTrue())
+		})
+	})
+
+	Context("binders", func() {
+		BeforeEach(func() {
+			rootCommand = &cobra.Command{
+				Use:          "poke",
+				SilenceUsage: true,
+				RunE: func(_ *cobra.Command, _ []string) error {
+					return paramSet.Validate()
+				},
+			}
+			paramSet = assistant.NewParamSet[RangeParameterSet](rootCommand)
+			assistant.BindValidatedRangeWithin(paramSet,
+				assistant.NewFlagInfo("depth of traversal", "d", assistant.NewRange(1, 3)),
+				&paramSet.Native.Depth, assistant.NewRange(0, 10),
+			)
+			assistant.BindRange(paramSet,
+				assistant.NewFlagInfo("ports to listen on", "p", nil),
+				&paramSet.Native.Ports,
+			)
+			assistant.BindRange(paramSet,
+				assistant.NewFlagInfo("timeout range", "t", nil),
+				&paramSet.Native.Timeout,
+			)
+		})
+
+		It("🧪 should: bind ranges", func() {
+			_, err := lab.ExecuteCommand(rootCommand,
+				"--depth", "2..5", "--ports", "8000-8100", "--timeout", "1s..1m30s",
+			)
+
+			Expect(err).To(Succeed())
+			Expect(paramSet.Native.Depth).To(Equal(assistant.NewRange(2, 5)))
+			Expect(paramSet.Native.Ports.Contains(8080)).To(BeTrue())
+			Expect(paramSet.Native.Timeout.High).To(Equal(90 * time.Second))
+		})
+
+		It("🧪 should: use default", func() {
+			_, err := lab.ExecuteCommand(rootCommand)
+
+			Expect(err).To(Succeed())
+			Expect(paramSet.Native.Depth).To(Equal(assistant.NewRange(1, 3)))
+			Expect(rootCommand.Flags().Lookup("depth").DefValue).To(Equal("1..3"))
+		})
+
+		It("🧪 should: reject inverted range", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--depth", "5..2")
+
+			Expect(err).To(MatchError(ContainSubstring("'5..2' is not a valid range")))
+		})
+
+		It("🧪 should: reject range outside bounds", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--depth", "2..")
+
+			Expect(err).To(HaveOccurred())
+			_, ok := err.(locale.WithinOptValidationBehaviourQuery)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("🧪 should: reject port out of range of type", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--ports", "8000-80000")
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("given: bounds changed between manifests", func() {
+		manifest := func(bounds assistant.Range[int]) *assistant.Manifest {
+			command := &cobra.Command{Use: "poke"}
+			container := assistant.NewCobraContainer(command)
+			ps := assistant.NewParamSet[RangeParameterSet](command)
+			assistant.BindValidatedRangeWithin(ps,
+				assistant.NewFlagInfo("depth of traversal", "d", assistant.NewRange(1, 3)),
+				&ps.Native.Depth, bounds,
+			)
+			container.MustRegisterParamSet("range-ps", ps)
+
+			data, _ := container.Manifest().Marshal()
+			result, _ := assistant.UnmarshalManifest(data)
+
+			return result
+		}
+		open := assistant.Range[int]{Low: 0, HasLow: true}
+
+		It("🧪 should: compare bounds numerically", func() {
+			Expect(assistant.CheckCompatibility(
+				manifest(assistant.NewRange(0, 9)), manifest(assistant.NewRange(0, 10)),
+			)).To(BeEmpty())
+			Expect(assistant.CheckCompatibility(
+				manifest(assistant.NewRange(0, 10)), manifest(assistant.NewRange(0, 9)),
+			)).To(HaveLen(1))
+		})
+
+		It("🧪 should: treat exclusive high bound as narrower", func() {
+			exclusive := assistant.Range[int]{Low: 0, High: 10, HasLow: true, HasHigh: true, ExcludeHigh: true}
+
+			Expect(manifest(exclusive).Root.Flags[0].Validator.Params).To(HaveKeyWithValue("excludeHigh", true))
+			Expect(assistant.CheckCompatibility(
+				manifest(exclusive), manifest(assistant.NewRange(0, 10)),
+			)).To(BeEmpty())
+			Expect(assistant.CheckCompatibility(
+				manifest(assistant.NewRange(0, 10)), manifest(exclusive),
+			)).To(HaveLen(1))
+		})
+
+		It("🧪 should: treat open bound as unbounded", func() {
+			Expect(assistant.CheckCompatibility(
+				manifest(assistant.NewRange(0, 10)), manifest(open),
+			)).To(BeEmpty())
+			Expect(assistant.CheckCompatibility(
+				manifest(open), manifest(assistant.NewRange(0, 10)),
+			)).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,195 @@
+package assistant
+
+import (
+	"context"
+	"slices"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/internal/third/lo"
+)
+
+// membership defines which flags activate a parameter set. A flag may be a
+// member of more than one parameter set. Flags that are not a member of any
+// parameter set are common to all and play no part in resolution.
+type membership struct {
+	mandatory []string
+	optional  []string
+	isDefault bool
+}
+
+func (m *membership) declared() bool {
+	return len(m.mandatory) > 0 || len(m.optional) > 0 || m.isDefault
+}
+
+func (m *membership) contains(flag string) bool {
+	return slices.Contains(m.mandatory, flag) || slices.Contains(m.optional, flag)
+}
+
+// Mandatory declares flags that are members of this parameter set, that
+// must all be provided by the user for the parameter set to be resolved
+// (see CobraContainer.ResolveParamSet).
+func (params *ParamSet[N]) Mandatory(flags ...string) *ParamSet[N] {
+	params.membership.mandatory = append(params.membership.mandatory, flags...)
+
+	return params
+}
+
+// Member declares flags that are optional members of this parameter set.
+func (params *ParamSet[N]) Member(flags ...string) *ParamSet[N] {
+	params.membership.optional = append(params.membership.optional, flags...)
+
+	return params
+}
+
+// AsDefault declares this parameter set as the one resolved when more than
+// one parameter set matches the flags provided.
+func (params *ParamSet[N]) AsDefault() *ParamSet[N] {
+	params.membership.isDefault = true
+
+	return params
+}
+
+// ResolvedParamSet is the parameter set resolved by CobraContainer.ResolveParamSet.
+type ResolvedParamSet struct {
+	// Name the parameter set was registered with
+	//
+	Name string
+
+	// ParamSet is the parameter set (*ParamSet[N])
+	//
+	ParamSet any
+
+	// Native is the native parameter set (*N)
+	//
+	Native any
+}
+
+// ResolveParamSet determines which of the parameter sets registered with the
+// container and bound to the command applies, according to the flags the
+// user has provided, in a similar manner to PowerShell parameter sets. Only
+// parameter sets that declare membership (see ParamSet.Mandatory, ParamSet.Member
+// and ParamSet.AsDefault) take part. A parameter set is a candidate if all
+// of its mandatory flags have been provided and all flags provided, that are a
+// member of any parameter set, are members of it. When there is more than 1
+// candidate, the default parameter set is resolved if it is a candidate.
+//
+// Returns nil without error, if no parameter set bound to the command
+// declares membership, a locale.NoMatchingParamSetBehaviourQuery error if there are no
+// candidates and a locale.AmbiguousParamSetBehaviourQuery error if the
+// candidates can't be resolved to a single parameter set.
+func (container *CobraContainer) ResolveParamSet(command *cobra.Command) (*ResolvedParamSet, error) {
+	names := lo.Filter(lo.Keys(container.paramSets), func(name string, _ int) bool {
+		descriptor, ok := container.paramSets[name].(paramSetDescriptor)
+
+		return ok && descriptor.boundCommand() == command && descriptor.members().declared()
+	})
+	slices.Sort(names)
+
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	descriptors := lo.Map(names, func(name string, _ int) paramSetDescriptor {
+		return container.paramSets[name].(paramSetDescriptor)
+	})
+
+	provided := providedMembers(command, descriptors)
+	candidates := lo.Filter(names, func(_ string, i int) bool {
+		m := descriptors[i].members()
+
+		return lo.EveryBy(m.mandatory, func(flag string) bool {
+			return slices.Contains(provided, flag)
+		}) && lo.EveryBy(provided, m.contains)
+	})
+
+	resolve := func(name string) *ResolvedParamSet {
+		return &ResolvedParamSet{
+			Name:     name,
+			ParamSet: container.paramSets[name],
+			Native:   container.paramSets[name].(paramSetDescriptor).native(),
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, locale.NewNoMatchingParamSetValidationError(command.Name(), provided)
+
+	case 1:
+		return resolve(candidates[0]), nil
+	}
+
+	defaults := lo.Filter(candidates, func(name string, _ int) bool {
+		return container.paramSets[name].(paramSetDescriptor).members().isDefault
+	})
+
+	if len(defaults) == 1 {
+		return resolve(defaults[0]), nil
+	}
+
+	return nil, locale.NewAmbiguousParamSetValidationError(command.Name(), candidates)
+}
+
+// providedMembers returns the names of the flags, that are a member of any of
+// the parameter sets, that the user has provided, in name order.
+func providedMembers(command *cobra.Command, descriptors []paramSetDescriptor) []string {
+	provided := []string{}
+
+	visit := func(flag *pflag.Flag) {
+		if slices.Contains(provided, flag.Name) {
+			return
+		}
+
+		if slices.ContainsFunc(descriptors, func(descriptor paramSetDescriptor) bool {
+			return descriptor.members().contains(flag.Name)
+		}) {
+			provided = append(provided, flag.Name)
+		}
+	}
+
+	command.Flags().Visit(visit)
+	command.InheritedFlags().Visit(visit)
+	slices.Sort(provided)
+
+	return provided
+}
+
+type resolvedParamSetKey struct{}
+
+// ResolveParamSetMiddleware creates middleware that resolves the parameter
+// set of the command being invoked (see CobraContainer.ResolveParamSet) and
+// makes it available to the command's run function via ResolvedParamSetFrom.
+// Resolution errors are returned without invoking the run function.
+func ResolveParamSetMiddleware(container *CobraContainer) Middleware {
+	return func(next RunE) RunE {
+		return func(command *cobra.Command, args []string) error {
+			resolved, err := container.ResolveParamSet(command)
+			if err != nil {
+				return err
+			}
+
+			parent := command.Context()
+			if parent == nil {
+				parent = context.Background()
+			}
+
+			command.SetContext(context.WithValue(parent, resolvedParamSetKey{}, resolved))
+
+			return next(command, args)
+		}
+	}
+}
+
+// ResolvedParamSetFrom returns the parameter set resolved by
+// ResolveParamSetMiddleware, nil if not present.
+func ResolvedParamSetFrom(ctx context.Context) *ResolvedParamSet {
+	if ctx == nil {
+		return nil
+	}
+
+	resolved, _ := ctx.Value(resolvedParamSetKey{}).(*ResolvedParamSet)
+
+	return resolved
+}
@@ -0,0 +1,181 @@
+package assistant_test
+
+import (
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant"
+	"github.com/snivilised/cobrass/src/assistant/locale"
+)
+
+type PathParameterSet struct {
+	Path    string
+	Recurse bool
+}
+
+type LiteralParameterSet struct {
+	LiteralPath string
+}
+
+type StdinParameterSet struct {
+	Stdin bool
+}
+
+type resolverTE struct {
+	given     string
+	should    string
+	args      []string
+	expected  string
+	ambiguous bool
+	noMatch   bool
+}
+
+var _ = Describe("ResolveParamSet", func() {
+	var (
+		container   *assistant.CobraContainer
+		copyCommand *cobra.Command
+		resolved    *assistant.ResolvedParamSet
+	)
+
+	BeforeEach(func() {
+		resolved = nil
+		container = assistant.NewCobraContainer(&cobra.Command{Use: "poke"})
+		copyCommand = &cobra.Command{
+			Use:          "copy",
+			SilenceUsage: true,
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				resolved = assistant.ResolvedParamSetFrom(cmd.Context())
+
+				return nil
+			},
+		}
+		container.MustRegisterRootedCommand(copyCommand)
+
+		pathParamSet := assistant.NewParamSet[PathParameterSet](copyCommand)
+		pathParamSet.BindString(
+			assistant.NewFlagInfo("path to copy", "p", ""),
+			&pathParamSet.Native.Path,
+		).BindBool(
+			assistant.NewFlagInfo("recurse into directories", "r", false),
+			&pathParamSet.Native.Recurse,
+		).Mandatory("path").Member("recurse")
+		container.MustRegisterParamSet("path-ps", pathParamSet)
+
+		literalParamSet := assistant.NewParamSet[LiteralParameterSet](copyCommand)
+		literalParamSet.BindString(
+			assistant.NewFlagInfo("literal-path to copy", "l", ""),
+			&literalParamSet.Native.LiteralPath,
+		).Mandatory("literal-path").Member("recurse")
+		container.MustRegisterParamSet("literal-ps", literalParamSet)
+
+		stdinParamSet := assistant.NewParamSet[StdinParameterSet](copyCommand)
+		stdinParamSet.BindBool(
+			assistant.NewFlagInfo("stdin read paths from stdin", "s", false),
+			&stdinParamSet.Native.Stdin,
+		).Member("stdin", "recurse").AsDefault()
+		container.MustRegisterParamSet("stdin-ps", stdinParamSet)
+
+		container.UseMiddleware(assistant.ResolveParamSetMiddleware(container))
+	})
+
+	DescribeTable("resolution",
+		func(entry *resolverTE) {
+			container.Root().SetArgs(append([]string{"copy"}, entry.args...))
+			container.Root().SilenceErrors = true
+			err := container.ExecuteE()
+
+			switch {
+			case entry.ambiguous:
+				Expect(err).To(HaveOccurred())
+				query, ok := err.(locale.AmbiguousParamSetBehaviourQuery)
+				Expect(ok).To(BeTrue())
+				Expect(query.IsAmbiguousParamSet()).To(BeTrue())
+
+			case entry.noMatch:
+				Expect(err).To(HaveOccurred())
+				query, ok := err.(locale.NoMatchingParamSetBehaviourQuery)
+				Expect(ok).To(BeTrue())
+				Expect(query.IsNoMatchingParamSet()).To(BeTrue())
+
+			default:
+				Expect(err).To(Succeed())
+				Expect(resolved).NotTo(BeNil())
+				Expect(resolved.Name).To(Equal(entry.expected))
+			}
+		},
+		func(entry *resolverTE) string {
+			return "🧪 --> given: " + entry.given + ", should: " + entry.should
+		},
+
+		Entry(nil, &resolverTE{
+			given:    "mandatory flag of path set",
+			should:   "resolve path set",
+			args:     []string{"--path", "/foo", "--recurse"},
+			expected: "path-ps",
+		}),
+
+		Entry(nil, &resolverTE{
+			given:    "mandatory flag of literal set",
+			should:   "resolve literal set",
+			args:     []string{"--literal-path", "/foo"},
+			expected: "literal-ps",
+		}),
+
+		Entry(nil, &resolverTE{
+			given:    "only flag shared by all sets",
+			should:   "resolve default set",
+			args:     []string{"--recurse"},
+			expected: "stdin-ps",
+		}),
+
+		Entry(nil, &resolverTE{
+			given:    "no flags",
+			should:   "resolve default set",
+			args:     []string{},
+			expected: "stdin-ps",
+		}),
+
+		Entry(nil, &resolverTE{
+			given:   "flags of different sets",
+			should:  "return no match error",
+			args:    []string{"--path", "/foo", "--literal-path", "/bar"},
+			noMatch: true,
+		}),
+	)
+
+	Context("given: multiple candidates without default", func() {
+		It("🧪 should: return ambiguous error", func() {
+			gadgetCommand := &cobra.Command{Use: "gadget"}
+			container.MustRegisterRootedCommand(gadgetCommand)
+
+			first := assistant.NewParamSet[PathParameterSet](gadgetCommand)
+			first.BindBool(
+				assistant.NewFlagInfo("recurse into directories", "r", false),
+				&first.Native.Recurse,
+			).Member("recurse")
+			container.MustRegisterParamSet("first-ps", first)
+
+			second := assistant.NewParamSet[StdinParameterSet](gadgetCommand)
+			second.Member("recurse")
+			container.MustRegisterParamSet("second-ps", second)
+
+			_, err := container.ResolveParamSet(gadgetCommand)
+			Expect(err).To(HaveOccurred())
+
+			failures := assistant.ValidationFailures(err)
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0].Params).To(HaveKeyWithValue(
+				"candidates", []string{"first-ps", "second-ps"},
+			))
+		})
+	})
+
+	Context("given: command without participating parameter sets", func() {
+		It("🧪 should: not resolve", func() {
+			resolved, err := container.ResolveParamSet(container.Root())
+			Expect(err).To(Succeed())
+			Expect(resolved).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,162 @@
+package assistant
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+)
+
+// ValueCodec defines how values of a client defined type T are parsed from
+// and formatted to their command line string representation, enabling types
+// not natively supported by pflag to be bound (see BindValue).
+type ValueCodec[T any] struct {
+	// Parse converts the string provided on the command line to T
+	//
+	Parse func(string) (T, error)
+
+	// Format converts T to its string representation, as displayed in
+	// the help text for the default value. Defaults to fmt.Sprint.
+	//
+	Format func(T) string
+
+	// Type is the name of the type reported by the flag (pflag.Value.Type),
+	// defaults to the go type name of T.
+	//
+	Type string
+}
+
+// ValueValidatorFn defines the validator function for a value of type T
+// bound by BindValidatedValue.
+type ValueValidatorFn[T any] func(T, *pflag.Flag) error
+
+// codecValue is the pflag.Value that binds a value of type T via its codec.
+type codecValue[T any] struct {
+	to    *T
+	codec *ValueCodec[T]
+	flag  string
+}
+
+func (v *codecValue[T]) String() string {
+	if v.codec.Format != nil {
+		return v.codec.Format(*v.to)
+	}
+
+	return fmt.Sprint(*v.to)
+}
+
+func (v *codecValue[T]) Set(s string) error {
+	value, err := v.codec.Parse(s)
+	if err != nil {
+		return locale.Attribute(err, v.flag)
+	}
+
+	*v.to = value
+
+	return nil
+}
+
+func (v *codecValue[T]) Type() string {
+	if v.codec.Type != "" {
+		return v.codec.Type
+	}
+
+	return fmt.Sprintf("%T", *v.to)
+}
+
+// NewValue creates a pflag.Value that binds the value of type T pointed to,
+// using the codec.
+func NewValue[T any](to *T, codec *ValueCodec[T]) pflag.Value {
+	return &codecValue[T]{
+		to:    to,
+		codec: codec,
+	}
+}
+
+// BindValue binds a flag of the client defined type T with a shorthand if
+// 'info.Short' has been set otherwise binds without a short name. The value
+// is parsed and formatted by the codec. If set, 'info.Default' must be of
+// type T or a string, which is parsed by the codec. This is a function
+// rather than a method of ParamSet, because go does not allow methods to
+// declare their own type parameters. Eg:
+//
+//	assistant.BindValue(paramSet,
+//		assistant.NewFlagInfo("endpoint url of the service", "e", url.URL{}),
+//		&paramSet.Native.Endpoint,
+//		&assistant.ValueCodec[url.URL]{
+//			Parse: func(s string) (url.URL, error) { ... },
+//		},
+//	)
+func BindValue[N, T any](params *ParamSet[N], info *FlagInfo, to *T, codec *ValueCodec[T]) *ParamSet[N] {
+	switch def := info.Default.(type) {
+	case nil:
+	case T:
+		*to = def
+	case string:
+		if def != "" {
+			*to = mustParseDefault(info, codec, def)
+		}
+	default:
+		*to = info.Default.(T)
+	}
+
+	return BindFlagValue(params, info, &codecValue[T]{
+		to:    to,
+		codec: codec,
+		flag:  info.FlagName(),
+	})
+}
+
+// mustParseDefault parses the default of the flag, specified as a string.
+func mustParseDefault[T any](info *FlagInfo, codec *ValueCodec[T], def string) T {
+	value, err := codec.Parse(def)
+	if err != nil {
+		panic(locale.NewInvalidDefaultValueNativeError(info.FlagName(), def, err))
+	}
+
+	return value
+}
+
+// BindValidatedValue binds a flag of the client defined type T with a
+// shorthand if 'info.Short' has been set otherwise binds without a short
+// name (see BindValue). Client can provide a function to validate option
+// values of type T, which participates in the parameter set's validation.
+func BindValidatedValue[N, T any](params *ParamSet[N], info *FlagInfo, to *T, codec *ValueCodec[T],
+	validator ValueValidatorFn[T],
+) GenericOptionValidatorWrapper[T] {
+	return bindValidatedValue(params, info, to, codec, validator, nil)
+}
+
+func bindValidatedValue[N, T any](params *ParamSet[N], info *FlagInfo, to *T, codec *ValueCodec[T],
+	validator ValueValidatorFn[T], constraint *ValidatorConstraint,
+) GenericOptionValidatorWrapper[T] {
+	BindValue(params, info, to, codec)
+
+	wrapper := GenericOptionValidatorWrapper[T]{
+		Fn:         validator,
+		Value:      to,
+		Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+		Constraint: constraint,
+	}
+	params.validators.Add(info.FlagName(), wrapper)
+
+	return wrapper
+}
+
+// BindFlagValue binds a flag whose value is implemented by the client as a
+// pflag.Value with a shorthand if 'info.Short' has been set otherwise binds
+// without a short name. The default value is the value's current state,
+// so 'info.Default' is ignored.
+func BindFlagValue[N any](params *ParamSet[N], info *FlagInfo, value pflag.Value) *ParamSet[N] {
+	flagSet := params.ResolveFlagSet(info)
+	if info.Short == "" {
+		flagSet.Var(value, info.FlagName(), info.Usage)
+	} else {
+		flagSet.VarP(value, info.FlagName(), info.Short, info.Usage)
+	}
+
+	markSensitive(flagSet, info)
+
+	return params
+}
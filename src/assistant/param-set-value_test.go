@@ -0,0 +1,156 @@
+package assistant_test
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant"
+	"github.com/snivilised/cobrass/src/internal/lab"
+)
+
+type version struct {
+	major, minor int
+}
+
+var versionCodec = &assistant.ValueCodec[version]{
+	Parse: func(s string) (version, error) {
+		var v version
+
+		if _, err := fmt.Sscanf(s, "%d.%d", &v.major, &v.minor); err != nil {
+			return v, fmt.Errorf("invalid version: '%v'", s)
+		}
+
+		return v, nil
+	},
+	Format: func(v version) string {
+		return fmt.Sprintf("%d.%d", v.major, v.minor)
+	},
+	Type: "version",
+}
+
+var urlCodec = &assistant.ValueCodec[url.URL]{
+	Parse: func(s string) (url.URL, error) {
+		u, err := url.Parse(s)
+		if err != nil {
+			return url.URL{}, err
+		}
+
+		return *u, nil
+	},
+	Format: func(u url.URL) string {
+		return u.String()
+	},
+}
+
+type ValueParameterSet struct {
+	Version  version
+	Endpoint url.URL
+	Labels   labels
+}
+
+// labels is a client defined pflag.Value
+type labels []string
+
+func (l *labels) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *labels) Set(s string) error {
+	*l = append(*l, s)
+
+	return nil
+}
+
+func (l *labels) Type() string {
+	return "labels"
+}
+
+var _ = Describe("BindValue", func() {
+	var (
+		rootCommand *cobra.Command
+		paramSet    *assistant.ParamSet[ValueParameterSet]
+	)
+
+	BeforeEach(func() {
+		rootCommand = &cobra.Command{
+			Use:          "poke",
+			SilenceUsage: true,
+			RunE: func(_ *cobra.Command, _ []string) error {
+				return paramSet.Validate()
+			},
+		}
+		paramSet = assistant.NewParamSet[ValueParameterSet](rootCommand)
+
+		assistant.BindValidatedValue(paramSet,
+			assistant.NewFlagInfo("version of the api", "v", version{major: 1}),
+			&paramSet.Native.Version,
+			versionCodec,
+			func(v version, _ *pflag.Flag) error {
+				if v.major < 1 {
+					return fmt.Errorf("unsupported version: '%v.%v'", v.major, v.minor)
+				}
+
+				return nil
+			},
+		)
+		assistant.BindValue(paramSet,
+			assistant.NewFlagInfo("endpoint url of the service", "", nil),
+			&paramSet.Native.Endpoint,
+			urlCodec,
+		)
+		assistant.BindFlagValue(paramSet,
+			assistant.NewFlagInfo("label to apply", "l", nil),
+			&paramSet.Native.Labels,
+		)
+	})
+
+	Context("given: values provided", func() {
+		It("🧪 should: parse values", func() {
+			_, err := lab.ExecuteCommand(rootCommand,
+				"--version", "2.3", "--endpoint", "https://example.com/api", "-l", "a", "-l", "b",
+			)
+
+			Expect(err).To(Succeed())
+			Expect(paramSet.Native.Version).To(Equal(version{major: 2, minor: 3}))
+			Expect(paramSet.Native.Endpoint.Host).To(Equal("example.com"))
+			Expect(paramSet.Native.Labels).To(HaveExactElements("a", "b"))
+		})
+	})
+
+	Context("given: values not provided", func() {
+		It("🧪 should: use defaults", func() {
+			_, err := lab.ExecuteCommand(rootCommand)
+
+			Expect(err).To(Succeed())
+			Expect(paramSet.Native.Version).To(Equal(version{major: 1}))
+
+			flag := rootCommand.Flags().Lookup("version")
+			Expect(flag.DefValue).To(Equal("1.0"))
+			Expect(flag.Value.Type()).To(Equal("version"))
+			Expect(rootCommand.Flags().Lookup("endpoint").Value.Type()).To(Equal("url.URL"))
+		})
+	})
+
+	Context("given: value fails to parse", func() {
+		It("🧪 should: return parse error", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--version", "latest")
+
+			Expect(err).To(MatchError(ContainSubstring("invalid version: 'latest'")))
+		})
+	})
+
+	Context("given: value fails validation", func() {
+		It("🧪 should: return validation error", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--version", "0.9")
+
+			Expect(err).To(MatchError("unsupported version: '0.9'"))
+			Expect(paramSet.Validators().Get("version")).NotTo(BeNil())
+		})
+	})
+})
@@ -41,6 +41,22 @@ type FlagInfo struct {
 	// value.
 	//
 	Validator StringValidatorFn
+
+	// Required indicates that the user must provide a value for the flag,
+	// which is enforced by ParamSet.Validate.
+	//
+	Required bool
+
+	// FromFile indicates that the value of the flag may be read from a file,
+	// by specifying the value as "@path" (see ParamSet.ReadValueFiles).
+	//
+	FromFile bool
+
+	// Sensitive indicates that the value of the flag must not be displayed,
+	// eg a token, so it is redacted in validation errors, help, manifests
+	// and logs (see Redact).
+	//
+	Sensitive bool
 }
 
 func extractNameFromUsage(usage string) string {
@@ -116,6 +132,11 @@ func (info *FlagInfo) FlagName() string {
 // var paramSet *ParamSet[WidgetParameterSet].
 type ParamSet[N any] struct {
 	validators *ValidatorContainer
+	enums      map[string]EnumDescriptor
+	membership *membership
+	shortFlags *shortFlagPolicyHolder
+	required   *requirements
+	valueFiles *flagRefs
 	// Native is the native client defined parameter set instance, which
 	// must be a struct.
 	//
@@ -155,10 +176,54 @@ func NewParamSet[N any](command *cobra.Command) (ps *ParamSet[N]) {
 	}
 
 	ps.validators = NewValidatorContainer()
+	ps.enums = make(map[string]EnumDescriptor)
+	ps.membership = &membership{}
+	ps.shortFlags = &shortFlagPolicyHolder{}
+	ps.required = &requirements{
+		validators: ps.validators,
+		enums:      ps.enums,
+	}
+	ps.valueFiles = &flagRefs{}
 
 	return ps
 }
 
+// paramSetDescriptor provides a non generic view of a ParamSet, so that the
+// meta data of heterogeneous parameter sets registered with the CobraContainer
+// can be queried.
+type paramSetDescriptor interface {
+	boundCommand() *cobra.Command
+	Validators() *ValidatorContainer
+	enumOf(flag string) EnumDescriptor
+	members() *membership
+	native() any
+}
+
+func (params *ParamSet[N]) boundCommand() *cobra.Command {
+	return params.Command
+}
+
+func (params *ParamSet[N]) members() *membership {
+	return params.membership
+}
+
+func (params *ParamSet[N]) native() any {
+	return params.Native
+}
+
+func (params *ParamSet[N]) enumOf(flag string) EnumDescriptor {
+	return params.enums[flag]
+}
+
+// AttachEnum associates the enum (typically an EnumInfo) with the flag that
+// was bound with BindEnum/BindValidatedEnum, so that its acceptable values
+// can be reported, eg in the manifest (see CobraContainer.Manifest).
+func (params *ParamSet[N]) AttachEnum(flag string, enum EnumDescriptor) *ParamSet[N] {
+	params.enums[flag] = enum
+
+	return params
+}
+
 // Validators returns the compound validator that the client will need to invoke
 // option validation (Run), typically inside the Run function defined on
 // the cobra command.
@@ -166,20 +231,98 @@ func (params *ParamSet[N]) Validators() *ValidatorContainer {
 	return params.validators
 }
 
+// flagRef refers to a flag by name, as it is resolved before being defined
+// (see ResolveFlagSet).
+type flagRef struct {
+	flagSet *pflag.FlagSet
+	name    string
+}
+
+// flagRefs is a collection of flags marked with a FlagInfo attribute, eg
+// FromFile, which is shared with embedded parameter sets.
+type flagRefs struct {
+	flags []flagRef
+}
+
+func (r *flagRefs) add(flagSet *pflag.FlagSet, name string) {
+	r.flags = append(r.flags, flagRef{flagSet: flagSet, name: name})
+}
+
+// defined returns the flags referred to that have since been defined.
+func (r *flagRefs) defined() []*pflag.Flag {
+	flags := make([]*pflag.Flag, 0, len(r.flags))
+
+	for _, ref := range r.flags {
+		if flag := ref.flagSet.Lookup(ref.name); flag != nil {
+			flags = append(flags, flag)
+		}
+	}
+
+	return flags
+}
+
 // ResolveFlagSet resolves between the default flag set on the param set
 // and the optional one defined on the FlagInfo. If there is no default
 // flag set, then there must be one on the flag info, otherwise a panic
 // will occur due dereferencing a nil pointer.
+//
+// If a short flag policy has been set (see WithShortFlagPolicy), the short
+// name of a flag that has not yet been bound is allocated by the policy and
+// if the flag is marked as Required, the requirement is registered.
 func (params *ParamSet[N]) ResolveFlagSet(info *FlagInfo) *pflag.FlagSet {
-	return lo.Ternary(info.AlternativeFlagSet == nil, params.FlagSet, info.AlternativeFlagSet)
+	flagSet := lo.Ternary(info.AlternativeFlagSet == nil, params.FlagSet, info.AlternativeFlagSet)
+
+	if flagSet.Lookup(info.Name) == nil {
+		params.allocateShortFlag(info, flagSet)
+
+		if info.Required {
+			params.required.add(&requirement{flag: info.Name, flagSet: flagSet})
+		}
+
+		if info.FromFile {
+			params.valueFiles.add(flagSet, info.Name)
+		}
+	}
+
+	return flagSet
 }
 
-// Validate invokes all option validators and returns the first error
-// encountered.
+// Validate checks that all required options have been provided (see
+// FlagInfo.Required and ParamSet.RequiredIf), then invokes all option
+// validators and returns the first error encountered. When prompting is
+// enabled (see WithRequiredPrompter), missing and invalid option values are
+// prompted for instead. The values of sensitive flags are redacted from the
+// errors (see FlagInfo.Sensitive).
 func (params *ParamSet[N]) Validate() error {
+	if errs := params.required.check(params.Command, true); len(errs) > 0 {
+		return errs[0]
+	}
+
+	if params.required.prompting() {
+		if errs := params.required.revalidate(true); len(errs) > 0 {
+			return errs[0]
+		}
+
+		return nil
+	}
+
 	return params.validators.run()
 }
 
+// ValidateAll checks that all required options have been provided, then
+// invokes all option validators and returns all errors encountered as a
+// locale.AggregateValidationError, so that all failures can be reported to
+// the user at once.
+func (params *ParamSet[N]) ValidateAll() error {
+	errs := params.required.check(params.Command, false)
+
+	if params.required.prompting() {
+		return locale.NewAggregateValidationError(append(errs, params.required.revalidate(false)...))
+	}
+
+	return locale.NewAggregateValidationError(append(errs, params.validators.collect()...))
+}
+
 // CrossValidate provides an optional way to perform cross field validation
 // on the native parameter set. It invokes the client validator function which
 // should be done after all parsed values have been bound and individually validated.
@@ -0,0 +1,241 @@
+package assistant
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/internal/third/lo"
+	nef "github.com/snivilised/nefilim"
+)
+
+const (
+	// PluginAnnotation is the annotation set on proxy commands, whose value
+	// is the path of the plugin executable.
+	PluginAnnotation = "cobrass_annotation_plugin"
+
+	// PluginManifestSuffix is appended to the name of the plugin executable
+	// to form the name of the plugin manifest file, which resides in the
+	// same directory as the executable.
+	PluginManifestSuffix = ".manifest.json"
+
+	executableSuffix = ".exe"
+	executableMode   = fs.FileMode(0o111)
+)
+
+// PluginManifest is published by a plugin, so that the host cli can provide
+// help and completion for the plugin, without having to invoke it.
+type PluginManifest struct {
+	Short   string   `json:"short,omitempty"`
+	Long    string   `json:"long,omitempty"`
+	Aliases []string `json:"aliases,omitempty"`
+
+	// ValidArgs are the positional arguments offered by completion
+	//
+	ValidArgs []string `json:"valid-args,omitempty"`
+
+	// Flags are offered by completion
+	//
+	Flags []*FlagManifest `json:"flags,omitempty"`
+}
+
+// PluginInfo describes a discovered plugin.
+type PluginInfo struct {
+	// Name is the name of the sub command the plugin is registered as
+	//
+	Name string
+
+	// Path of the plugin executable
+	//
+	Path string
+
+	// Manifest published by the plugin, nil if not present
+	//
+	Manifest *PluginManifest
+}
+
+// PluginRunner invokes the plugin executable on behalf of the proxy command.
+type PluginRunner interface {
+	Run(command *cobra.Command, plugin *PluginInfo, args []string) error
+}
+
+// ExecPluginRunner is the default PluginRunner, which runs the plugin as a
+// child process, connected to the stdio of the proxy command. A non zero
+// exit code is returned as a locale.PluginExitError, so that it is
+// propagated by CobraContainer.Execute.
+type ExecPluginRunner struct{}
+
+func (ExecPluginRunner) Run(command *cobra.Command, plugin *PluginInfo, args []string) error {
+	ctx := command.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	child := exec.CommandContext(ctx, plugin.Path, args...) //nolint:gosec // the plugin is intentionally executed
+	child.Stdin = command.InOrStdin()
+	child.Stdout = command.OutOrStdout()
+	child.Stderr = command.ErrOrStderr()
+
+	if err := child.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return locale.NewPluginExitError(plugin.Name, exitErr.ExitCode())
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// PluginOptions plugin discovery options.
+type PluginOptions struct {
+	// SearchPath is the list of directories searched for plugins, in
+	// order of precedence. Defaults to the directories of the PATH
+	// environment variable.
+	//
+	SearchPath []string
+
+	// Runner invokes the plugin, defaults to ExecPluginRunner
+	//
+	Runner PluginRunner
+}
+
+// PluginOptionFn definition of a client defined function to
+// set PluginOptions.
+type PluginOptionFn func(o *PluginOptions)
+
+// DiscoverPlugins searches for executables named "<root>-<sub>" in the
+// directories of the search path, on the file system specified and
+// registers a proxy command named "<sub>" on the root command for each one
+// found. The proxy forwards its arguments, stdio and exit code to and from
+// the plugin. Where a plugin exists in more than one directory, the first
+// one found wins and a plugin never replaces a command that is already
+// registered. Directories that do not exist are ignored.
+func (container *CobraContainer) DiscoverPlugins(fS nef.ReaderFS, options ...PluginOptionFn) []*PluginInfo {
+	option := PluginOptions{
+		SearchPath: filepath.SplitList(os.Getenv("PATH")),
+		Runner:     ExecPluginRunner{},
+	}
+
+	for _, functionalOption := range options {
+		functionalOption(&option)
+	}
+
+	prefix := container.root.Name() + "-"
+	plugins := []*PluginInfo{}
+
+	for _, directory := range option.SearchPath {
+		entries, err := fS.ReadDir(directory)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			name, isPlugin := pluginName(entry, prefix)
+
+			if !isPlugin || container.isRegistered(name) {
+				continue
+			}
+
+			path := fS.Calc().Join(directory, entry.Name())
+			plugin := &PluginInfo{
+				Name:     name,
+				Path:     path,
+				Manifest: readPluginManifest(fS, path),
+			}
+
+			container.MustRegisterRootedCommand(proxyCommand(plugin, option.Runner))
+			plugins = append(plugins, plugin)
+		}
+	}
+
+	return plugins
+}
+
+func (container *CobraContainer) isRegistered(name string) bool {
+	return container.IsPresent(name) || slices.ContainsFunc(container.root.Commands(), func(c *cobra.Command) bool {
+		return c.Name() == name
+	})
+}
+
+func pluginName(entry fs.DirEntry, prefix string) (string, bool) {
+	name := entry.Name()
+
+	if entry.IsDir() || !strings.HasPrefix(name, prefix) || strings.HasSuffix(name, PluginManifestSuffix) {
+		return "", false
+	}
+
+	sub := strings.TrimPrefix(name, prefix)
+
+	if strings.HasSuffix(sub, executableSuffix) {
+		sub = strings.TrimSuffix(sub, executableSuffix)
+
+		return sub, sub != ""
+	}
+
+	info, err := entry.Info()
+	if err != nil || info.Mode()&executableMode == 0 {
+		return "", false
+	}
+
+	return sub, sub != ""
+}
+
+func readPluginManifest(fS nef.ReaderFS, path string) *PluginManifest {
+	data, err := fS.ReadFile(path + PluginManifestSuffix)
+	if err != nil {
+		return nil
+	}
+
+	manifest := &PluginManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil
+	}
+
+	return manifest
+}
+
+func proxyCommand(plugin *PluginInfo, runner PluginRunner) *cobra.Command {
+	command := &cobra.Command{
+		Use:                plugin.Name,
+		Short:              "plugin: " + plugin.Path,
+		DisableFlagParsing: true,
+		SilenceUsage:       true,
+		Annotations: map[string]string{
+			PluginAnnotation: plugin.Path,
+		},
+		RunE: func(command *cobra.Command, args []string) error {
+			return runner.Run(command, plugin, args)
+		},
+	}
+
+	if manifest := plugin.Manifest; manifest != nil {
+		command.Short = lo.Ternary(manifest.Short == "", command.Short, manifest.Short)
+		command.Long = manifest.Long
+		command.Aliases = manifest.Aliases
+		command.ValidArgsFunction = func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if !strings.HasPrefix(toComplete, "-") {
+				return manifest.ValidArgs, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			flags := lo.Map(manifest.Flags, func(flag *FlagManifest, _ int) string {
+				return "--" + flag.Name
+			})
+			slices.Sort(flags)
+
+			return flags, cobra.ShellCompDirectiveNoFileComp
+		}
+	}
+
+	return command
+}
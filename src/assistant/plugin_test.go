@@ -0,0 +1,142 @@
+package assistant_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing/fstest"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant"
+	nef "github.com/snivilised/nefilim"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+type fakePluginRunner struct {
+	plugin *assistant.PluginInfo
+	args   []string
+}
+
+func (r *fakePluginRunner) Run(_ *cobra.Command, plugin *assistant.PluginInfo, args []string) error {
+	r.plugin = plugin
+	r.args = args
+
+	return nil
+}
+
+var _ = Describe("DiscoverPlugins", func() {
+	var (
+		container *assistant.CobraContainer
+		runner    *fakePluginRunner
+		fS        *luna.MemFS
+	)
+
+	BeforeEach(func() {
+		container = assistant.NewCobraContainer(&cobra.Command{Use: "poke"})
+		container.MustRegisterRootedCommand(&cobra.Command{Use: "builtin"})
+		runner = &fakePluginRunner{}
+
+		fS = luna.NewMemFS()
+		fS.MapFS["bin/poke-widget"] = &fstest.MapFile{Mode: 0o755}
+		fS.MapFS["bin/poke-widget.manifest.json"] = &fstest.MapFile{
+			Mode: 0o644,
+			Data: []byte(`{
+  "short": "widget plugin",
+  "aliases": ["w"],
+  "valid-args": ["foo", "bar"],
+  "flags": [{ "name": "offset", "type": "int" }, { "name": "concise", "type": "bool" }]
+}`),
+		}
+		fS.MapFS["bin/poke-readme"] = &fstest.MapFile{Mode: 0o644}
+		fS.MapFS["bin/poke-builtin"] = &fstest.MapFile{Mode: 0o755}
+		fS.MapFS["bin/other-tool"] = &fstest.MapFile{Mode: 0o755}
+		fS.MapFS["alt/poke-widget"] = &fstest.MapFile{Mode: 0o755}
+		fS.MapFS["alt/poke-gadget.exe"] = &fstest.MapFile{Mode: 0o644}
+	})
+
+	discover := func() []*assistant.PluginInfo {
+		return container.DiscoverPlugins(fS, func(o *assistant.PluginOptions) {
+			o.SearchPath = []string{"missing", "bin", "alt"}
+			o.Runner = runner
+		})
+	}
+
+	Context("given: executables on the search path", func() {
+		It("🧪 should: register proxy commands for plugins", func() {
+			plugins := discover()
+
+			Expect(plugins).To(HaveLen(2))
+			Expect(plugins[0].Name).To(Equal("widget"))
+			Expect(plugins[0].Path).To(Equal("bin/poke-widget"))
+			Expect(plugins[1].Name).To(Equal("gadget"))
+			Expect(plugins[1].Manifest).To(BeNil())
+
+			Expect(container.IsPresent("widget")).To(BeTrue())
+			Expect(container.IsPresent("gadget")).To(BeTrue())
+			Expect(container.IsPresent("readme")).To(BeFalse())
+			Expect(container.Command("widget").Annotations).To(
+				HaveKeyWithValue(assistant.PluginAnnotation, "bin/poke-widget"),
+			)
+		})
+	})
+
+	Context("given: plugin is invoked", func() {
+		It("🧪 should: forward arguments to plugin", func() {
+			discover()
+			container.Root().SetArgs([]string{"w", "--offset", "3", "foo"})
+
+			Expect(container.ExecuteE()).To(Succeed())
+			Expect(runner.plugin.Name).To(Equal("widget"))
+			Expect(runner.args).To(HaveExactElements("--offset", "3", "foo"))
+		})
+	})
+
+	Context("given: plugin publishes manifest", func() {
+		It("🧪 should: provide help and completion", func() {
+			discover()
+			widget := container.Command("widget")
+
+			Expect(widget.Short).To(Equal("widget plugin"))
+
+			args, _ := widget.ValidArgsFunction(widget, []string{}, "")
+			Expect(args).To(HaveExactElements("foo", "bar"))
+
+			flags, _ := widget.ValidArgsFunction(widget, []string{}, "--")
+			Expect(flags).To(HaveExactElements("--concise", "--offset"))
+		})
+	})
+})
+
+var _ = Describe("ExecPluginRunner", func() {
+	Context("given: plugin executable", func() {
+		It("🧪 should: forward stdio and exit code", func() {
+			if runtime.GOOS == "windows" {
+				Skip("requires posix shell")
+			}
+
+			directory := GinkgoT().TempDir()
+			script := "#!/bin/sh\necho \"args: $*\"\ncat\nexit 3\n"
+			Expect(os.WriteFile(
+				filepath.Join(directory, "poke-script"), []byte(script), 0o755, //nolint:gosec // test executable
+			)).To(Succeed())
+
+			container := assistant.NewCobraContainer(&cobra.Command{Use: "poke"})
+			container.DiscoverPlugins(nef.NewReaderABS(), func(o *assistant.PluginOptions) {
+				o.SearchPath = []string{directory}
+			})
+
+			stdout := &bytes.Buffer{}
+			container.Root().SetIn(bytes.NewBufferString("from stdin\n"))
+			container.Root().SetOut(stdout)
+			container.Root().SetArgs([]string{"script", "--flag", "value"})
+			container.UseErrorRenderer(&fakeRenderer{})
+
+			Expect(container.Execute()).To(Equal(3))
+			Expect(stdout.String()).To(Equal("args: --flag value\nfrom stdin\n"))
+		})
+	})
+})
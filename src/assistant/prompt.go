@@ -0,0 +1,291 @@
+package assistant
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/internal/third/lo"
+)
+
+// maxPromptAttempts is the number of times the user is prompted for the
+// value of an option, before the validation error is returned.
+const maxPromptAttempts = 3
+
+// PromptRequest describes the option value the user is being prompted for.
+type PromptRequest struct {
+	// Flag is the flag whose value is required
+	//
+	Flag *pflag.Flag
+
+	// Acceptables are the acceptable values of an enum flag (see
+	// ParamSet.AttachEnum), which can be presented as a pick list.
+	//
+	Acceptables []string
+
+	// Failure is the error arising from the previous value, nil if this is
+	// the first request for the value.
+	//
+	Failure error
+}
+
+// PromptFn returns the value, in its string form, of the flag in the
+// request.
+type PromptFn func(request *PromptRequest) (string, error)
+
+// RequiredPrompter supplies the value of a required flag that the user has
+// not provided on the command line and replaces values that fail option
+// validation, typically by prompting the user interactively.
+type RequiredPrompter interface {
+	// Enabled determines whether values should be supplied by the prompter.
+	// It is invoked at validation time, ie after the command line has been
+	// parsed, so it can depend on the value of a flag, eg --tui.
+	//
+	Enabled() bool
+
+	// Prompt returns the value of the flag in the request.
+	//
+	Prompt(request *PromptRequest) (string, error)
+}
+
+type requiredPrompter struct {
+	enabled func() bool
+	prompt  PromptFn
+}
+
+func (p *requiredPrompter) Enabled() bool {
+	return p.enabled()
+}
+
+func (p *requiredPrompter) Prompt(request *PromptRequest) (string, error) {
+	return p.prompt(request)
+}
+
+// NewRequiredPrompter creates a RequiredPrompter, which invokes prompt when
+// enabled returns true.
+func NewRequiredPrompter(enabled func() bool, prompt PromptFn) RequiredPrompter {
+	return &requiredPrompter{
+		enabled: enabled,
+		prompt:  prompt,
+	}
+}
+
+// WithRequiredPrompter sets the prompter that is given the opportunity to
+// supply the value of a required flag that has not been provided, before a
+// missing required option error is raised and to replace a value that fails
+// option validation, before the validation error is raised. Values supplied
+// by the prompter are subject to option validation as if provided on the
+// command line.
+func (params *ParamSet[N]) WithRequiredPrompter(prompter RequiredPrompter) *ParamSet[N] {
+	params.required.prompter = prompter
+
+	return params
+}
+
+func (r *requirements) prompting() bool {
+	return r.prompter != nil && r.prompter.Enabled()
+}
+
+// prompt obtains the value of the flag from the prompter, re-prompting if
+// the value can't be parsed.
+func (r *requirements) prompt(flag *pflag.Flag, failure error) error {
+	request := &PromptRequest{
+		Flag:    flag,
+		Failure: failure,
+	}
+
+	if enum, found := r.enums[flag.Name]; found && enum != nil {
+		request.Acceptables = lo.Keys(enum.Aliases())
+		slices.Sort(request.Acceptables)
+	}
+
+	for attempt := 1; ; attempt++ {
+		value, err := r.prompter.Prompt(request)
+		if err != nil {
+			return err
+		}
+
+		err = setPrompted(flag, value)
+
+		if err == nil {
+			flag.Changed = true
+
+			return nil
+		}
+
+		if attempt == maxPromptAttempts {
+			return err
+		}
+
+		request.Failure = lo.Ternary(IsSensitive(flag), redactArgument(err, value), err)
+	}
+}
+
+// setPrompted sets the value of the flag to the prompted value. The value
+// of a slice flag replaces its existing elements, rather than being
+// appended to them, as the existing value may be the one being replaced.
+func setPrompted(flag *pflag.Flag, value string) error {
+	slice, ok := flag.Value.(pflag.SliceValue)
+	if !ok {
+		return flag.Value.Set(value)
+	}
+
+	elements := []string{}
+
+	if value != "" {
+		var err error
+
+		if elements, err = csv.NewReader(strings.NewReader(value)).Read(); err != nil {
+			return err
+		}
+	}
+
+	return slice.Replace(elements)
+}
+
+// revalidate invokes all validators in flag name order, prompting for a
+// replacement value when validation fails. When first is true, validation
+// stops at the first error.
+func (r *requirements) revalidate(first bool) []error {
+	flags := lo.Keys(r.validators.validators)
+	slices.Sort(flags)
+
+	errs := []error{}
+
+	for _, name := range flags {
+		validator := r.validators.validators[name]
+
+		if err := r.reprompt(validator); err != nil {
+			errs = append(errs, err)
+
+			if first {
+				break
+			}
+		}
+	}
+
+	return errs
+}
+
+func (r *requirements) reprompt(validator OptionValidator) error {
+	for attempt := 1; ; attempt++ {
+		err := validate(validator)
+
+		if err == nil || validator.GetFlag() == nil || attempt > maxPromptAttempts {
+			return err
+		}
+
+		if err := r.prompt(validator.GetFlag(), err); err != nil {
+			return err
+		}
+	}
+}
+
+// InteractivePrompterOptions interactive prompter options.
+type InteractivePrompterOptions struct {
+	// Reader is the source of the user's responses, defaults to os.Stdin
+	//
+	Reader io.Reader
+
+	// Writer is the destination of the prompts, defaults to os.Stderr, so
+	// that they are not mixed with the output of the command.
+	//
+	Writer io.Writer
+
+	// IsTerminal determines whether the user can respond to prompts,
+	// defaults to checking that os.Stdin is a terminal.
+	//
+	IsTerminal func() bool
+}
+
+// InteractivePrompterOptionFn definition of a client defined function to
+// set InteractivePrompterOptions.
+type InteractivePrompterOptionFn func(o *InteractivePrompterOptions)
+
+// InteractivePrompter is a RequiredPrompter that prompts the user for
+// option values on a terminal, using the flag's usage as the prompt and
+// offering its default value, which is accepted by responding with an
+// empty line. The acceptable values of an enum flag are presented as a
+// numbered pick list, from which the user can respond with either the
+// number or the value.
+type InteractivePrompter struct {
+	enabled    func() bool
+	reader     *bufio.Reader
+	writer     io.Writer
+	isTerminal func() bool
+}
+
+// NewInteractivePrompter creates an InteractivePrompter that is enabled
+// when enabled returns true and the user is at a terminal.
+func NewInteractivePrompter(enabled func() bool,
+	options ...InteractivePrompterOptionFn,
+) *InteractivePrompter {
+	option := InteractivePrompterOptions{
+		Reader:     os.Stdin,
+		Writer:     os.Stderr,
+		IsTerminal: isStdinTerminal,
+	}
+
+	for _, functionalOption := range options {
+		functionalOption(&option)
+	}
+
+	return &InteractivePrompter{
+		enabled:    enabled,
+		reader:     bufio.NewReader(option.Reader),
+		writer:     option.Writer,
+		isTerminal: option.IsTerminal,
+	}
+}
+
+func isStdinTerminal() bool {
+	info, err := os.Stdin.Stat()
+
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+func (p *InteractivePrompter) Enabled() bool {
+	return p.enabled() && p.isTerminal()
+}
+
+func (p *InteractivePrompter) Prompt(request *PromptRequest) (string, error) {
+	if request.Failure != nil {
+		_, _ = fmt.Fprintf(p.writer, "%v\n", request.Failure)
+	}
+
+	for i, acceptable := range request.Acceptables {
+		_, _ = fmt.Fprintf(p.writer, "  %v) %v\n", i+1, acceptable)
+	}
+
+	flag := request.Flag
+
+	if flag.DefValue == "" || IsSensitive(flag) {
+		_, _ = fmt.Fprintf(p.writer, "%v: ", flag.Usage)
+	} else {
+		_, _ = fmt.Fprintf(p.writer, "%v [%v]: ", flag.Usage, flag.DefValue)
+	}
+
+	line, err := p.reader.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+
+	answer := strings.TrimSpace(line)
+
+	if answer == "" && !IsSensitive(flag) {
+		return flag.DefValue, nil
+	}
+
+	if n, err := strconv.Atoi(answer); err == nil && n >= 1 && n <= len(request.Acceptables) {
+		return request.Acceptables[n-1], nil
+	}
+
+	return answer, nil
+}
@@ -0,0 +1,301 @@
+package assistant_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing/fstest"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant"
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/internal/lab"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+type PromptSliceParameterSet struct {
+	Tags []string
+}
+
+type promptTE struct {
+	given    string
+	should   string
+	args     []string
+	input    string
+	terminal bool
+	source   string
+	format   string
+	count    int
+	prompts  int
+	failed   bool
+}
+
+var _ = Describe("InteractivePrompter", Ordered, func() {
+	var (
+		rootCommand *cobra.Command
+		paramSet    *assistant.ParamSet[RequiredParameterSet]
+		formatEnum  assistant.EnumValue[OutputFormatEnum]
+		output      *bytes.Buffer
+	)
+
+	BeforeAll(func() {
+		if err := li18ngo.Use(func(o *li18ngo.UseOptions) {
+			o.From = li18ngo.LoadFrom{
+				Path: lab.Path(lab.Repo("../.."), "Test/data/l10n"),
+				Sources: li18ngo.TranslationFiles{
+					locale.CobrassSourceID: li18ngo.TranslationSource{Name: "test"},
+				},
+			}
+		}); err != nil {
+			Fail(err.Error())
+		}
+	})
+
+	setup := func(input string, terminal bool) {
+		output = &bytes.Buffer{}
+		rootCommand = &cobra.Command{
+			Use:          "poke",
+			SilenceUsage: true,
+			RunE: func(_ *cobra.Command, _ []string) error {
+				return paramSet.Validate()
+			},
+		}
+		paramSet = assistant.NewParamSet[RequiredParameterSet](rootCommand)
+
+		info := assistant.NewFlagInfo("source file to read", "s", "")
+		info.Required = true
+		paramSet.BindString(info, &paramSet.Native.Source)
+
+		formatInfo := assistant.NewEnumInfo(AcceptableOutputFormats)
+		formatEnum = formatInfo.NewValue()
+		paramSet.BindValidatedEnum(
+			assistant.NewFlagInfo("format of output", "f", "xml"),
+			&formatEnum.Source,
+			func(value string, _ *pflag.Flag) error {
+				if formatInfo.IsValid(value) {
+					return nil
+				}
+
+				return fmt.Errorf("format: '%v' is not valid", value)
+			},
+		)
+		paramSet.AttachEnum("format", formatInfo)
+
+		paramSet.BindValidatedIntWithin(
+			assistant.NewFlagInfo("count of items", "c", 1),
+			&paramSet.Native.Count, 1, 10,
+		)
+
+		paramSet.WithRequiredPrompter(assistant.NewInteractivePrompter(
+			func() bool { return true },
+			func(o *assistant.InteractivePrompterOptions) {
+				o.Reader = strings.NewReader(input)
+				o.Writer = output
+				o.IsTerminal = func() bool { return terminal }
+			},
+		))
+	}
+
+	DescribeTable("prompting",
+		func(entry *promptTE) {
+			setup(entry.input, entry.terminal)
+			_, err := lab.ExecuteCommand(rootCommand, entry.args...)
+
+			if entry.failed {
+				Expect(err).To(HaveOccurred())
+
+				return
+			}
+
+			Expect(err).To(Succeed())
+			Expect(paramSet.Native.Source).To(Equal(entry.source))
+			Expect(formatEnum.Source).To(Equal(entry.format))
+			Expect(paramSet.Native.Count).To(Equal(entry.count))
+			Expect(strings.Count(output.String(), "source file to read")).To(Equal(entry.prompts))
+		},
+		func(entry *promptTE) string {
+			return "🧪 --> given: " + entry.given + ", should: " + entry.should
+		},
+
+		Entry(nil, &promptTE{
+			given:    "all options valid",
+			should:   "not prompt",
+			args:     []string{"--source", "foo.txt"},
+			terminal: true,
+			source:   "foo.txt",
+			format:   "xml",
+			count:    1,
+		}),
+
+		Entry(nil, &promptTE{
+			given:    "missing required option",
+			should:   "prompt for value",
+			input:    "bar.txt\n",
+			terminal: true,
+			source:   "bar.txt",
+			format:   "xml",
+			count:    1,
+			prompts:  1,
+		}),
+
+		Entry(nil, &promptTE{
+			given:    "invalid option",
+			should:   "re-prompt for value",
+			args:     []string{"--source", "foo.txt", "--count", "99"},
+			input:    "abc\n5\n",
+			terminal: true,
+			source:   "foo.txt",
+			format:   "xml",
+			count:    5,
+		}),
+
+		Entry(nil, &promptTE{
+			given:    "invalid enum option",
+			should:   "pick from acceptables",
+			args:     []string{"--source", "foo.txt", "--format", "csv"},
+			input:    "2\n",
+			terminal: true,
+			source:   "foo.txt",
+			format:   "scribble",
+			count:    1,
+		}),
+
+		Entry(nil, &promptTE{
+			given:    "invalid option and empty response",
+			should:   "accept default",
+			args:     []string{"--source", "foo.txt", "--count", "0"},
+			input:    "\n",
+			terminal: true,
+			source:   "foo.txt",
+			format:   "xml",
+			count:    1,
+		}),
+
+		Entry(nil, &promptTE{
+			given:    "invalid option re-entered too many times",
+			should:   "fail",
+			args:     []string{"--source", "foo.txt", "--count", "99"},
+			input:    "99\n99\n99\n",
+			terminal: true,
+			failed:   true,
+		}),
+
+		Entry(nil, &promptTE{
+			given:    "not a terminal",
+			should:   "fail without prompting",
+			input:    "bar.txt\n",
+			terminal: false,
+			failed:   true,
+		}),
+	)
+
+	Context("given: input exhausted", func() {
+		It("🧪 should: return read error", func() {
+			setup("", true)
+			_, err := lab.ExecuteCommand(rootCommand)
+
+			Expect(err).To(MatchError(io.EOF))
+		})
+	})
+
+	Context("given: enum option prompted", func() {
+		It("🧪 should: present acceptables as pick list", func() {
+			setup("json\n", true)
+			_, err := lab.ExecuteCommand(rootCommand, "--source", "foo.txt", "--format", "csv")
+
+			Expect(err).To(Succeed())
+			Expect(formatEnum.Source).To(Equal("json"))
+			Expect(output.String()).To(ContainSubstring("format: 'csv' is not valid"))
+			Expect(output.String()).To(ContainSubstring("  1) json\n  2) scribble\n  3) text\n  4) xml\n"))
+			Expect(output.String()).To(ContainSubstring("format of output [xml]: "))
+		})
+	})
+
+	Context("given: invalid slice option prompted", func() {
+		It("🧪 should: replace rather than append to existing value", func() {
+			output = &bytes.Buffer{}
+			command := &cobra.Command{
+				Use:          "poke",
+				SilenceUsage: true,
+			}
+			sliceParamSet := assistant.NewParamSet[PromptSliceParameterSet](command)
+			sliceParamSet.BindValidatedStringSlice(
+				assistant.NewFlagInfo("tags to apply", "t", []string{}),
+				&sliceParamSet.Native.Tags,
+				func(tags []string, _ *pflag.Flag) error {
+					if len(tags) > 2 {
+						return fmt.Errorf("tags: too many tags '%v'", len(tags))
+					}
+
+					return nil
+				},
+			)
+			sliceParamSet.WithRequiredPrompter(assistant.NewInteractivePrompter(
+				func() bool { return true },
+				func(o *assistant.InteractivePrompterOptions) {
+					o.Reader = strings.NewReader("x,y\n")
+					o.Writer = output
+					o.IsTerminal = func() bool { return true }
+				},
+			))
+			command.RunE = func(_ *cobra.Command, _ []string) error {
+				return sliceParamSet.Validate()
+			}
+
+			_, err := lab.ExecuteCommand(command, "--tags", "a,b,c")
+
+			Expect(err).To(Succeed())
+			Expect(sliceParamSet.Native.Tags).To(Equal([]string{"x", "y"}))
+			Expect(output.String()).To(ContainSubstring("too many tags"))
+		})
+	})
+
+	Context("given: invalid file backed slice option prompted", func() {
+		It("🧪 should: replace value read from file", func() {
+			output = &bytes.Buffer{}
+			command := &cobra.Command{
+				Use:          "poke",
+				SilenceUsage: true,
+			}
+			sliceParamSet := assistant.NewParamSet[PromptSliceParameterSet](command)
+			info := assistant.NewFlagInfo("tags to apply", "t", []string{})
+			info.FromFile = true
+			sliceParamSet.BindValidatedStringSlice(info, &sliceParamSet.Native.Tags,
+				func(tags []string, _ *pflag.Flag) error {
+					if len(tags) > 2 {
+						return fmt.Errorf("tags: too many tags '%v'", len(tags))
+					}
+
+					return nil
+				},
+			)
+			sliceParamSet.WithRequiredPrompter(assistant.NewInteractivePrompter(
+				func() bool { return true },
+				func(o *assistant.InteractivePrompterOptions) {
+					o.Reader = strings.NewReader("x,y\n")
+					o.Writer = output
+					o.IsTerminal = func() bool { return true }
+				},
+			))
+			command.RunE = func(_ *cobra.Command, _ []string) error {
+				return sliceParamSet.Validate()
+			}
+
+			fS := luna.NewMemFS()
+			fS.MapFS["tags.txt"] = &fstest.MapFile{Mode: 0o644, Data: []byte("b\nc\n")}
+			sliceParamSet.ReadValueFiles(fS)
+
+			_, err := lab.ExecuteCommand(command, "--tags", "a", "--tags", "@tags.txt")
+
+			Expect(err).To(Succeed())
+			Expect(sliceParamSet.Native.Tags).To(Equal([]string{"x", "y"}))
+			Expect(output.String()).To(ContainSubstring("too many tags"))
+		})
+	})
+})
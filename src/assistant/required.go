@@ -0,0 +1,120 @@
+package assistant
+
+import (
+	"slices"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+)
+
+// requirement defines a flag that must be provided by the user. If a
+// dependency is defined, the flag is only required when the dependency
+// has been provided and, if values are defined, the dependency's value is
+// one of them.
+type requirement struct {
+	flag       string
+	flagSet    *pflag.FlagSet
+	dependency string
+	values     []string
+}
+
+type requirements struct {
+	rules      []*requirement
+	prompter   RequiredPrompter
+	validators *ValidatorContainer
+	enums      map[string]EnumDescriptor
+}
+
+func (r *requirements) add(rule *requirement) {
+	r.rules = append(r.rules, rule)
+}
+
+// check returns the errors for the required flags that have not been
+// provided and could not be supplied by the prompter. When first is true,
+// checking stops at the first error.
+func (r *requirements) check(command *cobra.Command, first bool) []error {
+	errs := []error{}
+	prompting := r.prompting()
+
+	for _, rule := range r.rules {
+		err := r.enforce(command, rule, prompting)
+
+		if err != nil {
+			errs = append(errs, err)
+
+			if first {
+				break
+			}
+		}
+	}
+
+	return errs
+}
+
+func (r *requirements) enforce(command *cobra.Command, rule *requirement, prompting bool) error {
+	flag := lookupFlag(command, rule.flagSet, rule.flag)
+
+	if flag == nil || flag.Changed {
+		return nil
+	}
+
+	var missing error
+
+	if rule.dependency == "" {
+		missing = locale.NewMissingRequiredOptionValidationError(rule.flag)
+	} else {
+		dependency := lookupFlag(command, nil, rule.dependency)
+
+		if dependency == nil || !dependency.Changed {
+			return nil
+		}
+
+		value := dependency.Value.String()
+
+		if len(rule.values) > 0 && !slices.Contains(rule.values, value) {
+			return nil
+		}
+
+		missing = locale.NewMissingRequiredIfOptionValidationError(rule.flag, rule.dependency, value)
+	}
+
+	if !prompting {
+		return missing
+	}
+
+	return r.prompt(flag, nil)
+}
+
+func lookupFlag(command *cobra.Command, flagSet *pflag.FlagSet, name string) *pflag.Flag {
+	if flagSet != nil {
+		if flag := flagSet.Lookup(name); flag != nil {
+			return flag
+		}
+	}
+
+	if flag := command.Flags().Lookup(name); flag != nil {
+		return flag
+	}
+
+	return command.InheritedFlags().Lookup(name)
+}
+
+// RequiredIf declares that the flag must be provided by the user, when the
+// dependency flag has been provided. If values are specified, the flag is
+// only required when the value of the dependency is one of them, eg:
+//
+//	paramSet.RequiredIf("output", "format", "json", "xml")
+//
+// ... means that --output is required when --format is either json or xml.
+// As with FlagInfo.Required, the requirement is enforced by Validate.
+func (params *ParamSet[N]) RequiredIf(flag, dependency string, values ...string) *ParamSet[N] {
+	params.required.add(&requirement{
+		flag:       flag,
+		dependency: dependency,
+		values:     values,
+	})
+
+	return params
+}
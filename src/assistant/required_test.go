@@ -0,0 +1,179 @@
+package assistant_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant"
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/internal/lab"
+	"github.com/snivilised/li18ngo"
+)
+
+type RequiredParameterSet struct {
+	Source string
+	Format string
+	Output string
+	Count  int
+}
+
+var _ = Describe("Required", Ordered, func() {
+	var (
+		rootCommand *cobra.Command
+		paramSet    *assistant.ParamSet[RequiredParameterSet]
+		validate    func(ps *assistant.ParamSet[RequiredParameterSet]) error
+	)
+
+	BeforeAll(func() {
+		if err := li18ngo.Use(func(o *li18ngo.UseOptions) {
+			o.From = li18ngo.LoadFrom{
+				Path: lab.Path(lab.Repo("../.."), "Test/data/l10n"),
+				Sources: li18ngo.TranslationFiles{
+					locale.CobrassSourceID: li18ngo.TranslationSource{Name: "test"},
+				},
+			}
+		}); err != nil {
+			Fail(err.Error())
+		}
+	})
+
+	BeforeEach(func() {
+		validate = func(ps *assistant.ParamSet[RequiredParameterSet]) error {
+			return ps.Validate()
+		}
+		rootCommand = &cobra.Command{
+			Use:          "poke",
+			SilenceUsage: true,
+			RunE: func(_ *cobra.Command, _ []string) error {
+				return validate(paramSet)
+			},
+		}
+		paramSet = assistant.NewParamSet[RequiredParameterSet](rootCommand)
+
+		info := assistant.NewFlagInfo("source file to read", "s", "")
+		info.Required = true
+		paramSet.BindString(info, &paramSet.Native.Source)
+		paramSet.BindString(
+			assistant.NewFlagInfo("format of output", "f", "text"),
+			&paramSet.Native.Format,
+		)
+		paramSet.BindString(
+			assistant.NewFlagInfo("output file to write", "o", ""),
+			&paramSet.Native.Output,
+		)
+		paramSet.BindValidatedIntWithin(
+			assistant.NewFlagInfo("count of items", "c", 1),
+			&paramSet.Native.Count, 1, 10,
+		)
+		paramSet.RequiredIf("output", "format", "json")
+	})
+
+	Context("given: required flag provided", func() {
+		It("🧪 should: succeed", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--source", "foo.txt")
+
+			Expect(err).To(Succeed())
+			Expect(paramSet.Native.Source).To(Equal("foo.txt"))
+		})
+	})
+
+	Context("given: required flag missing", func() {
+		It("🧪 should: return missing required option error", func() {
+			_, err := lab.ExecuteCommand(rootCommand)
+
+			Expect(err).To(HaveOccurred())
+			query, ok := err.(locale.MissingRequiredOptionBehaviourQuery)
+			Expect(ok).To(BeTrue())
+			Expect(query.IsMissingRequiredOption()).To(BeTrue())
+		})
+	})
+
+	Context("given: dependency has value requiring flag", func() {
+		It("🧪 should: return missing required if option error", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--source", "foo.txt", "--format", "json")
+
+			Expect(err).To(HaveOccurred())
+			_, ok := err.(locale.MissingRequiredIfOptionBehaviourQuery)
+			Expect(ok).To(BeTrue())
+
+			failures := assistant.ValidationFailures(err)
+			Expect(failures).To(HaveLen(1))
+			Expect(failures[0].Flag).To(Equal("output"))
+			Expect(failures[0].Params).To(HaveKeyWithValue("value", "json"))
+		})
+	})
+
+	Context("given: dependency has value not requiring flag", func() {
+		It("🧪 should: succeed", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--source", "foo.txt", "--format", "xml")
+
+			Expect(err).To(Succeed())
+		})
+	})
+
+	Context("given: ValidateAll with missing and invalid options", func() {
+		It("🧪 should: report all failures", func() {
+			validate = func(ps *assistant.ParamSet[RequiredParameterSet]) error {
+				return ps.ValidateAll()
+			}
+			_, err := lab.ExecuteCommand(rootCommand, "--format", "json", "--count", "99")
+
+			Expect(err).To(HaveOccurred())
+			failures := assistant.ValidationFailures(err)
+			Expect(failures).To(HaveLen(3))
+			Expect(failures[0].Constraint).To(Equal(locale.ConstraintRequired))
+			Expect(failures[1].Constraint).To(Equal(locale.ConstraintRequiredIf))
+			Expect(failures[2].Constraint).To(Equal("within"))
+		})
+	})
+
+	Context("given: enabled prompter", func() {
+		It("🧪 should: supply missing value", func() {
+			prompted := []string{}
+			paramSet.WithRequiredPrompter(assistant.NewRequiredPrompter(
+				func() bool { return true },
+				func(request *assistant.PromptRequest) (string, error) {
+					prompted = append(prompted, request.Flag.Name)
+
+					return "bar.txt", nil
+				},
+			))
+			_, err := lab.ExecuteCommand(rootCommand)
+
+			Expect(err).To(Succeed())
+			Expect(prompted).To(HaveExactElements("source"))
+			Expect(paramSet.Native.Source).To(Equal("bar.txt"))
+		})
+
+		It("🧪 should: return prompt error", func() {
+			paramSet.WithRequiredPrompter(assistant.NewRequiredPrompter(
+				func() bool { return true },
+				func(_ *assistant.PromptRequest) (string, error) {
+					return "", errors.New("prompt aborted")
+				},
+			))
+			_, err := lab.ExecuteCommand(rootCommand)
+
+			Expect(err).To(MatchError("prompt aborted"))
+		})
+	})
+
+	Context("given: disabled prompter", func() {
+		It("🧪 should: return missing required option error", func() {
+			paramSet.WithRequiredPrompter(assistant.NewRequiredPrompter(
+				func() bool { return false },
+				func(_ *assistant.PromptRequest) (string, error) {
+					return "bar.txt", nil
+				},
+			))
+			_, err := lab.ExecuteCommand(rootCommand)
+
+			Expect(err).To(HaveOccurred())
+			_, ok := err.(locale.MissingRequiredOptionBehaviourQuery)
+			Expect(ok).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,371 @@
+package assistant
+
+import (
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	nef "github.com/snivilised/nefilim"
+)
+
+const (
+	// ArgumentFilePrefix is the prefix of an argument that denotes a file,
+	// either a response file (see ExpandArgs) or a file containing the value
+	// of a flag (see FlagInfo.FromFile). The prefix is escaped by doubling
+	// it, eg "@@handle" is the literal argument "@handle".
+	ArgumentFilePrefix = "@"
+
+	// argumentsTerminator marks the end of the flags, arguments that follow
+	// it are not expanded.
+	argumentsTerminator = "--"
+
+	defaultMaxResponseFileDepth = 8
+)
+
+// ResponseFileOptions options that determine how response files are
+// expanded.
+type ResponseFileOptions struct {
+	// MaxDepth is the maximum depth of nested response files, ie response
+	// files that refer to other response files. Defaults to 8.
+	//
+	MaxDepth int
+
+	// ValueFlags are the flags, eg "--filter" or "-f", whose values are read
+	// from a file (see FlagInfo.FromFile), so the argument that follows them
+	// is not expanded as a response file.
+	//
+	ValueFlags []string
+}
+
+// ResponseFileOptionFn definition of a client defined function to set
+// ResponseFileOptions.
+type ResponseFileOptionFn func(o *ResponseFileOptions)
+
+// ExpandArgs expands the response files referred to by the arguments, ie
+// those of the form "@path", into the arguments they contain, read from the
+// file system specified. Arguments in a response file are separated by
+// white space, which may be preserved by quoting. Within single quotes all
+// characters are literal, within double quotes a backslash escapes a double
+// quote or a backslash and outside of quotes, a backslash escapes any
+// character. A "#" at the start of an argument begins a comment that runs
+// to the end of the line. Response files may refer to other response files,
+// whose relative paths are resolved against the directory of the referring
+// file. Arguments following "--" are not expanded and an escaped prefix is
+// removed, eg "@@handle" becomes "@handle".
+func ExpandArgs(fS nef.ReaderFS, args []string, options ...ResponseFileOptionFn) ([]string, error) {
+	option := ResponseFileOptions{
+		MaxDepth: defaultMaxResponseFileDepth,
+	}
+
+	for _, functionalOption := range options {
+		functionalOption(&option)
+	}
+
+	return expandArgs(fS, args, "", 0, &option)
+}
+
+func expandArgs(fS nef.ReaderFS, args []string, directory string, depth int,
+	option *ResponseFileOptions,
+) ([]string, error) {
+	expanded := make([]string, 0, len(args))
+
+	for i, arg := range args {
+		if arg == argumentsTerminator {
+			return append(expanded, args[i:]...), nil
+		}
+
+		if i > 0 && slices.Contains(option.ValueFlags, args[i-1]) {
+			expanded = append(expanded, arg)
+
+			continue
+		}
+
+		path, isFile := argumentFile(arg)
+		if !isFile {
+			expanded = append(expanded, path)
+
+			continue
+		}
+
+		if directory != "" && !filepath.IsAbs(path) {
+			path = fS.Calc().Clean(fS.Calc().Join(directory, path))
+		}
+
+		if depth >= option.MaxDepth {
+			return nil, locale.NewResponseFileError(path, errMaxResponseFileDepth)
+		}
+
+		contents, err := fS.ReadFile(path)
+		if err != nil {
+			return nil, locale.NewResponseFileError(path, err)
+		}
+
+		tokens, err := tokenise(string(contents))
+		if err != nil {
+			return nil, locale.NewResponseFileError(path, err)
+		}
+
+		nested, err := expandArgs(fS, tokens, fS.Calc().Dir(path), depth+1, option)
+		if err != nil {
+			return nil, err
+		}
+
+		expanded = append(expanded, nested...)
+	}
+
+	return expanded, nil
+}
+
+// argumentFile returns the path of the file denoted by the argument, if it
+// is prefixed by ArgumentFilePrefix, otherwise returns the argument with an
+// escaped prefix removed.
+func argumentFile(arg string) (string, bool) {
+	if !strings.HasPrefix(arg, ArgumentFilePrefix) || len(arg) == len(ArgumentFilePrefix) {
+		return arg, false
+	}
+
+	path := strings.TrimPrefix(arg, ArgumentFilePrefix)
+
+	if strings.HasPrefix(path, ArgumentFilePrefix) {
+		return path, false
+	}
+
+	return path, true
+}
+
+var (
+	errMaxResponseFileDepth = errors.New("response files nested too deeply")
+	errUnterminatedQuote    = errors.New("unterminated quote")
+)
+
+// tokenise splits the contents of a response file into arguments.
+func tokenise(contents string) ([]string, error) {
+	var (
+		tokens  []string
+		token   strings.Builder
+		quote   rune
+		escaped bool
+		pending bool
+		comment bool
+	)
+
+	for _, r := range contents {
+		switch {
+		case comment:
+			comment = r != '\n'
+
+		case escaped:
+			token.WriteRune(r)
+			escaped = false
+
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				token.WriteRune(r)
+			}
+
+		case quote == '"':
+			switch r {
+			case '"':
+				quote = 0
+			case '\\':
+				escaped = true
+			default:
+				token.WriteRune(r)
+			}
+
+		case r == '\'' || r == '"':
+			quote, pending = r, true
+
+		case r == '\\':
+			escaped, pending = true, true
+
+		case r == '#' && !pending:
+			comment = true
+
+		case unicode.IsSpace(r):
+			if pending {
+				tokens = append(tokens, token.String())
+				token.Reset()
+				pending = false
+			}
+
+		default:
+			token.WriteRune(r)
+			pending = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, errUnterminatedQuote
+	}
+
+	if pending {
+		tokens = append(tokens, token.String())
+	}
+
+	return tokens, nil
+}
+
+// ExpandResponseFiles is the pre-processing step that expands the response
+// files referred to by the arguments (see ExpandArgs) and sets the result as
+// the arguments of the root command. It also enables the flags of the
+// registered parameter sets that have been marked as FromFile, to read their
+// values from the same file system (see ParamSet.ReadValueFiles). Must be
+// invoked after all flags have been bound and before the container is
+// executed, eg:
+//
+//	if err := container.ExpandResponseFiles(nef.NewReaderABS(), os.Args[1:]); err != nil {
+//		...
+//	}
+//	os.Exit(container.Execute())
+func (container *CobraContainer) ExpandResponseFiles(fS nef.ReaderFS, args []string,
+	options ...ResponseFileOptionFn,
+) error {
+	valueFlags := []string{}
+
+	for _, ps := range container.paramSets {
+		if reader, ok := ps.(valueFileReader); ok {
+			reader.ReadValueFiles(fS)
+			valueFlags = append(valueFlags, reader.valueFileFlags()...)
+		}
+	}
+
+	expanded, err := ExpandArgs(fS, args, append([]ResponseFileOptionFn{
+		func(o *ResponseFileOptions) {
+			o.ValueFlags = valueFlags
+		},
+	}, options...)...)
+	if err != nil {
+		return err
+	}
+
+	container.root.SetArgs(expanded)
+
+	return nil
+}
+
+// valueFileReader is implemented by ParamSet, so that the container can
+// enable value files without knowing the native parameter set type.
+type valueFileReader interface {
+	ReadValueFiles(fS nef.ReaderFS)
+	valueFileFlags() []string
+}
+
+// ReadValueFiles enables the flags marked as FromFile, to read their value
+// from a file on the file system specified, when the value provided is of
+// the form "@path". The contents of the file, less any trailing new line,
+// becomes the value of the flag, except for slice flags, where each line of
+// the file is added as a separate element, ignoring blank lines. Invoked by
+// CobraContainer.ExpandResponseFiles for registered parameter sets.
+func (params *ParamSet[N]) ReadValueFiles(fS nef.ReaderFS) {
+	for _, flag := range params.valueFiles.defined() {
+		switch flag.Value.(type) {
+		case *fileValue, *fileSliceValue:
+			continue
+		}
+
+		value := &fileValue{
+			Value: flag.Value,
+			fS:    fS,
+			flag:  flag,
+		}
+
+		if slice, ok := flag.Value.(pflag.SliceValue); ok {
+			flag.Value = &fileSliceValue{fileValue: value, slice: slice}
+
+			continue
+		}
+
+		flag.Value = value
+	}
+}
+
+// valueFileFlags returns the command line forms of the flags marked as
+// FromFile, ie "--name" and "-short".
+func (params *ParamSet[N]) valueFileFlags() []string {
+	flags := []string{}
+
+	for _, flag := range params.valueFiles.defined() {
+		flags = append(flags, "--"+flag.Name)
+
+		if flag.Shorthand != "" {
+			flags = append(flags, "-"+flag.Shorthand)
+		}
+	}
+
+	return flags
+}
+
+// fileValue decorates the value of a flag, so that it may be read from a file
+type fileValue struct {
+	pflag.Value
+	fS   nef.ReaderFS
+	flag *pflag.Flag
+}
+
+func (v *fileValue) Set(s string) error {
+	path, isFile := argumentFile(s)
+	if !isFile {
+		return v.Value.Set(path)
+	}
+
+	contents, err := v.fS.ReadFile(path)
+	if err != nil {
+		return locale.NewValueFileError(v.flag.Name, path, err)
+	}
+
+	value := strings.TrimRight(string(contents), "\r\n")
+
+	if slice, ok := v.Value.(pflag.SliceValue); ok {
+		lines := []string{}
+
+		for _, line := range strings.Split(value, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				lines = append(lines, line)
+			}
+		}
+
+		// as per the slice flags, the first occurrence replaces the default
+		//
+		if !v.flag.Changed {
+			return slice.Replace(lines)
+		}
+
+		for _, line := range lines {
+			if err := slice.Append(line); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return v.Value.Set(value)
+}
+
+// fileSliceValue decorates the value of a slice flag, so that it may be read
+// from a file, whilst remaining a pflag.SliceValue, eg so that it can be
+// prompted for.
+type fileSliceValue struct {
+	*fileValue
+	slice pflag.SliceValue
+}
+
+func (v *fileSliceValue) Append(s string) error {
+	return v.slice.Append(s)
+}
+
+func (v *fileSliceValue) Replace(s []string) error {
+	return v.slice.Replace(s)
+}
+
+func (v *fileSliceValue) GetSlice() []string {
+	return v.slice.GetSlice()
+}
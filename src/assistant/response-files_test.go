@@ -0,0 +1,212 @@
+package assistant_test
+
+import (
+	"errors"
+	"io/fs"
+	"strings"
+	"testing/fstest"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant"
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/internal/lab"
+	"github.com/snivilised/li18ngo"
+	"github.com/snivilised/nefilim/test/luna"
+)
+
+type ResponseParameterSet struct {
+	Filter   string
+	Patterns []string
+	Depth    int
+}
+
+type expandArgsTE struct {
+	given    string
+	contents string
+	args     []string
+	expected []string
+}
+
+var _ = Describe("ResponseFiles", Ordered, func() {
+	var fS *luna.MemFS
+
+	BeforeAll(func() {
+		if err := li18ngo.Use(func(o *li18ngo.UseOptions) {
+			o.From = li18ngo.LoadFrom{
+				Path: lab.Path(lab.Repo("../.."), "Test/data/l10n"),
+				Sources: li18ngo.TranslationFiles{
+					locale.CobrassSourceID: li18ngo.TranslationSource{Name: "test"},
+				},
+			}
+		}); err != nil {
+			Fail(err.Error())
+		}
+	})
+
+	BeforeEach(func() {
+		fS = luna.NewMemFS()
+	})
+
+	DescribeTable("ExpandArgs",
+		func(entry *expandArgsTE) {
+			fS.MapFS["args.rsp"] = &fstest.MapFile{Mode: 0o644, Data: []byte(entry.contents)}
+			fS.MapFS["nested/inner.rsp"] = &fstest.MapFile{Mode: 0o644, Data: []byte("--depth 3 @more.rsp")}
+			fS.MapFS["nested/more.rsp"] = &fstest.MapFile{Mode: 0o644, Data: []byte("--hidden")}
+
+			args := entry.args
+			if args == nil {
+				args = []string{"@args.rsp"}
+			}
+
+			expanded, err := assistant.ExpandArgs(fS, args)
+
+			Expect(err).To(Succeed())
+			Expect(expanded).To(Equal(entry.expected))
+		},
+		func(entry *expandArgsTE) string {
+			return "🧪 --> given: " + entry.given + ", should: expand to '" +
+				strings.Join(entry.expected, " ") + "'"
+		},
+
+		Entry(nil, &expandArgsTE{
+			given:    "white space separated arguments",
+			contents: "--filter  *.go\n\t--depth 2\n",
+			expected: []string{"--filter", "*.go", "--depth", "2"},
+		}),
+		Entry(nil, &expandArgsTE{
+			given:    "quoted arguments",
+			contents: `--title "a \"big\" title" --path 'C:\dir name' ""`,
+			expected: []string{"--title", `a "big" title`, "--path", `C:\dir name`, ""},
+		}),
+		Entry(nil, &expandArgsTE{
+			given:    "escaped white space",
+			contents: `--path dir\ name`,
+			expected: []string{"--path", "dir name"},
+		}),
+		Entry(nil, &expandArgsTE{
+			given:    "comments",
+			contents: "# the filter\n--filter a#b # trailing\n--depth 1",
+			expected: []string{"--filter", "a#b", "--depth", "1"},
+		}),
+		Entry(nil, &expandArgsTE{
+			given:    "arguments surrounding response file",
+			contents: "--depth 1",
+			args:     []string{"widget", "@args.rsp", "--concise"},
+			expected: []string{"widget", "--depth", "1", "--concise"},
+		}),
+		Entry(nil, &expandArgsTE{
+			given:    "nested response files",
+			contents: "--filter x @nested/inner.rsp",
+			expected: []string{"--filter", "x", "--depth", "3", "--hidden"},
+		}),
+		Entry(nil, &expandArgsTE{
+			given:    "escaped prefix and terminator",
+			args:     []string{"@@handle", "@", "--", "@args.rsp"},
+			expected: []string{"@handle", "@", "--", "@args.rsp"},
+		}),
+	)
+
+	Context("given: invalid response file", func() {
+		It("🧪 should: fail when the file does not exist", func() {
+			_, err := assistant.ExpandArgs(fS, []string{"@missing.rsp"})
+
+			_, ok := err.(locale.ResponseFileBehaviourQuery)
+			Expect(ok).To(BeTrue())
+			Expect(errors.Is(err, fs.ErrNotExist)).To(BeTrue())
+		})
+
+		It("🧪 should: fail on unterminated quote", func() {
+			fS.MapFS["args.rsp"] = &fstest.MapFile{Mode: 0o644, Data: []byte(`--title "oops`)}
+			_, err := assistant.ExpandArgs(fS, []string{"@args.rsp"})
+
+			Expect(err).To(MatchError(ContainSubstring("unterminated quote")))
+		})
+
+		It("🧪 should: fail on recursive response files", func() {
+			fS.MapFS["args.rsp"] = &fstest.MapFile{Mode: 0o644, Data: []byte(`@args.rsp`)}
+			_, err := assistant.ExpandArgs(fS, []string{"@args.rsp"}, func(o *assistant.ResponseFileOptions) {
+				o.MaxDepth = 3
+			})
+
+			Expect(err).To(MatchError(ContainSubstring("nested too deeply")))
+		})
+	})
+
+	Context("CobraContainer.ExpandResponseFiles", func() {
+		var (
+			container *assistant.CobraContainer
+			paramSet  *assistant.ParamSet[ResponseParameterSet]
+		)
+
+		BeforeEach(func() {
+			rootCommand := &cobra.Command{
+				Use:          "poke",
+				SilenceUsage: true,
+				RunE: func(_ *cobra.Command, _ []string) error {
+					return nil
+				},
+			}
+			container = assistant.NewCobraContainer(rootCommand)
+			paramSet = assistant.NewParamSet[ResponseParameterSet](rootCommand)
+
+			filter := assistant.NewFlagInfo("filter applied to files", "f", "")
+			filter.FromFile = true
+			paramSet.BindString(filter, &paramSet.Native.Filter)
+
+			patterns := assistant.NewFlagInfo("patterns to match", "p", []string{"*.txt"})
+			patterns.FromFile = true
+			paramSet.BindStringSlice(patterns, &paramSet.Native.Patterns)
+
+			paramSet.BindInt(assistant.NewFlagInfo("depth of traversal", "d", 0),
+				&paramSet.Native.Depth,
+			)
+			container.MustRegisterParamSet("poke-ps", paramSet)
+
+			fS.MapFS["args.rsp"] = &fstest.MapFile{Mode: 0o644, Data: []byte("--filter @filter.txt --depth 2")}
+			fS.MapFS["filter.txt"] = &fstest.MapFile{Mode: 0o644, Data: []byte("*.go\n")}
+			fS.MapFS["patterns.txt"] = &fstest.MapFile{Mode: 0o644, Data: []byte("*.md\n\n*.yml\n")}
+		})
+
+		It("🧪 should: expand response file and read values from files", func() {
+			Expect(container.ExpandResponseFiles(fS, []string{
+				"@args.rsp", "--patterns", "@patterns.txt",
+			})).To(Succeed())
+			Expect(container.ExecuteE()).To(Succeed())
+
+			Expect(paramSet.Native.Filter).To(Equal("*.go"))
+			Expect(paramSet.Native.Depth).To(Equal(2))
+			Expect(paramSet.Native.Patterns).To(Equal([]string{"*.md", "*.yml"}))
+		})
+
+		It("🧪 should: accumulate slice values from files", func() {
+			Expect(container.ExpandResponseFiles(fS, []string{
+				"--patterns", "*.rs", "--patterns", "@patterns.txt",
+			})).To(Succeed())
+			Expect(container.ExecuteE()).To(Succeed())
+
+			Expect(paramSet.Native.Patterns).To(Equal([]string{"*.rs", "*.md", "*.yml"}))
+		})
+
+		It("🧪 should: use escaped value literally", func() {
+			Expect(container.ExpandResponseFiles(fS, []string{"--filter", "@@home"})).To(Succeed())
+			Expect(container.ExecuteE()).To(Succeed())
+
+			Expect(paramSet.Native.Filter).To(Equal("@home"))
+		})
+
+		It("🧪 should: not read value from file for unmarked flag", func() {
+			Expect(container.ExpandResponseFiles(fS, []string{"--depth", "@filter.txt"})).To(Succeed())
+
+			Expect(container.ExecuteE()).NotTo(Succeed())
+		})
+
+		It("🧪 should: fail when value file does not exist", func() {
+			Expect(container.ExpandResponseFiles(fS, []string{"--filter", "@missing.txt"})).To(Succeed())
+
+			Expect(container.ExecuteE()).To(MatchError(ContainSubstring("missing.txt")))
+		})
+	})
+})
@@ -0,0 +1,70 @@
+package assistant
+
+import (
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Scalar defines the types of values that can be parsed from their command
+// line string representation by parseScalar, which includes time.Duration.
+type Scalar interface {
+	~string | ~bool | RangeBound
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// parseScalar parses a single value, according to the kind of T, which may
+// be a client defined type whose underlying type is a Scalar.
+func parseScalar[T Scalar](s string) (T, error) {
+	var scalar T
+
+	value := reflect.ValueOf(&scalar).Elem()
+
+	switch {
+	case value.Type() == durationType:
+		duration, err := time.ParseDuration(s)
+		if err != nil {
+			return scalar, err
+		}
+
+		value.SetInt(int64(duration))
+
+	case value.Kind() == reflect.String:
+		value.SetString(s)
+
+	case value.Kind() == reflect.Bool:
+		flag, err := strconv.ParseBool(s)
+		if err != nil {
+			return scalar, err
+		}
+
+		value.SetBool(flag)
+
+	case value.CanInt():
+		number, err := strconv.ParseInt(s, 10, value.Type().Bits())
+		if err != nil {
+			return scalar, err
+		}
+
+		value.SetInt(number)
+
+	case value.CanUint():
+		number, err := strconv.ParseUint(s, 10, value.Type().Bits())
+		if err != nil {
+			return scalar, err
+		}
+
+		value.SetUint(number)
+
+	default:
+		number, err := strconv.ParseFloat(s, value.Type().Bits())
+		if err != nil {
+			return scalar, err
+		}
+
+		value.SetFloat(number)
+	}
+
+	return scalar, nil
+}
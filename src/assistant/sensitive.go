@@ -0,0 +1,121 @@
+package assistant
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+)
+
+// SensitiveAnnotation is the annotation set on flags marked as Sensitive
+// (see FlagInfo.Sensitive).
+const SensitiveAnnotation = "cobrass_annotation_sensitive"
+
+// IsSensitive determines whether the flag has been marked as Sensitive.
+func IsSensitive(flag *pflag.Flag) bool {
+	return flag != nil && len(flag.Annotations[SensitiveAnnotation]) > 0
+}
+
+// SensitiveFlagNames returns the bare long and short names of the sensitive
+// flags defined on the flag set, so that the command line composed for a
+// third party command can be redacted for display, eg:
+//
+//	clif.Redact(cl, assistant.SensitiveFlagNames(flagSet)...)
+func SensitiveFlagNames(flagSet *pflag.FlagSet) []string {
+	names := []string{}
+
+	flagSet.VisitAll(func(flag *pflag.Flag) {
+		if IsSensitive(flag) {
+			names = append(names, flag.Name)
+
+			if flag.Shorthand != "" {
+				names = append(names, flag.Shorthand)
+			}
+		}
+	})
+
+	return names
+}
+
+// markSensitive annotates the flag just bound, if it is marked as Sensitive
+// (see FlagInfo.Sensitive), and redacts its default value, so that it is not
+// displayed in help or manifests.
+func markSensitive(flagSet *pflag.FlagSet, info *FlagInfo) {
+	flag := flagSet.Lookup(info.Name)
+
+	if !info.Sensitive || flag == nil {
+		return
+	}
+
+	if flag.Annotations == nil {
+		flag.Annotations = map[string][]string{}
+	}
+
+	flag.Annotations[SensitiveAnnotation] = []string{"true"}
+
+	if flag.DefValue != "" {
+		flag.DefValue = locale.RedactedValue
+	}
+}
+
+// invalidArgumentRx matches the error reported by pflag when the value of
+// a flag can't be parsed, capturing the quoted value and the flag name.
+var invalidArgumentRx = regexp.MustCompile(`^invalid argument "((?:[^"\\]|\\.)*)" for "(?:-[^,]+, )?--([^"]+)" flag`)
+
+// redactFlagError redacts the value of a sensitive flag from the error that
+// occurred as the command line was parsed. The value of the flag reported
+// by the error, if any, is also returned.
+func redactFlagError(command *cobra.Command, err error) (value any, redacted error) {
+	match := invalidArgumentRx.FindStringSubmatch(err.Error())
+
+	if match == nil {
+		return nil, err
+	}
+
+	if !IsSensitive(command.Flags().Lookup(match[2])) {
+		return match[1], err
+	}
+
+	redacted = redactArgument(err, match[1])
+
+	if unquoted, unquoteErr := strconv.Unquote(`"` + match[1] + `"`); unquoteErr == nil {
+		redacted = redactArgument(redacted, unquoted)
+	}
+
+	return locale.RedactedValue, redacted
+}
+
+// redactArgument replaces the argument wherever it occurs in the error.
+func redactArgument(err error, argument string) error {
+	if err == nil || argument == "" {
+		return err
+	}
+
+	return errors.New(strings.ReplaceAll(err.Error(), argument, locale.RedactedValue))
+}
+
+// validate invokes the validator, redacting the error if the flag is
+// sensitive. Errors that are not cobrass validation errors, eg those
+// returned by a client validator function, are redacted by replacing
+// the value of the flag wherever it occurs in the error.
+func validate(validator OptionValidator) error {
+	err := validator.Validate()
+	flag := validator.GetFlag()
+
+	if err == nil || !IsSensitive(flag) {
+		return err
+	}
+
+	redacted := locale.Redact(err)
+
+	if value := flag.Value.String(); strings.Contains(redacted.Error(), value) {
+		return redactArgument(redacted, value)
+	}
+
+	return redacted
+}
@@ -0,0 +1,193 @@
+package assistant_test
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass"
+	"github.com/snivilised/cobrass/src/assistant"
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/clif"
+	"github.com/snivilised/cobrass/src/internal/lab"
+	"github.com/snivilised/li18ngo"
+)
+
+type SensitiveParameterSet struct {
+	Token string
+	Pin   int
+	Depth int
+}
+
+var _ = Describe("Sensitive", Ordered, func() {
+	var (
+		container *assistant.CobraContainer
+		paramSet  *assistant.ParamSet[SensitiveParameterSet]
+	)
+
+	BeforeAll(func() {
+		if err := li18ngo.Use(func(o *li18ngo.UseOptions) {
+			o.From = li18ngo.LoadFrom{
+				Path: lab.Path(lab.Repo("../.."), "Test/data/l10n"),
+				Sources: li18ngo.TranslationFiles{
+					locale.CobrassSourceID: li18ngo.TranslationSource{Name: "test"},
+				},
+			}
+		}); err != nil {
+			Fail(err.Error())
+		}
+	})
+
+	BeforeEach(func() {
+		rootCommand := &cobra.Command{
+			Use:          "poke",
+			SilenceUsage: true,
+			RunE: func(_ *cobra.Command, _ []string) error {
+				return paramSet.Validate()
+			},
+		}
+		container = assistant.NewCobraContainer(rootCommand)
+		paramSet = assistant.NewParamSet[SensitiveParameterSet](rootCommand)
+
+		token := assistant.NewFlagInfo("token used to authenticate", "t", "default-token")
+		token.Sensitive = true
+		paramSet.BindValidatedStringIsMatch(token, &paramSet.Native.Token, `^tk-`)
+
+		pin := assistant.NewFlagInfo("pin number", "p", 0)
+		pin.Sensitive = true
+		paramSet.BindInt(pin, &paramSet.Native.Pin)
+
+		paramSet.BindValidatedIntWithin(assistant.NewFlagInfo("depth of traversal", "d", 0),
+			&paramSet.Native.Depth, 0, 5,
+		)
+		container.MustRegisterParamSet("poke-ps", paramSet)
+	})
+
+	execute := func(args ...string) error {
+		container.Root().SetArgs(args)
+
+		return container.ExecuteE()
+	}
+
+	Context("given: valid sensitive value", func() {
+		It("🧪 should: pass real value to native parameter set", func() {
+			Expect(execute("--token", "tk-s3cr3t", "--pin", "1234")).To(Succeed())
+
+			Expect(paramSet.Native.Token).To(Equal("tk-s3cr3t"))
+			Expect(paramSet.Native.Pin).To(Equal(1234))
+		})
+
+		It("🧪 should: pass real value to third party command line", func() {
+			Expect(execute("--token", "tk-s3cr3t", "--depth", "2")).To(Succeed())
+
+			flagSet := container.Root().Flags()
+			cl := assistant.GetThirdPartyCL(flagSet, cobrass.KnownByCollection{
+				"token": "t", "depth": "d",
+			})
+			Expect(cl).To(ContainElement("tk-s3cr3t"))
+
+			display := clif.Redact(clif.ThirdPartyCommandLine(cl), assistant.SensitiveFlagNames(flagSet)...)
+			Expect(display).NotTo(ContainElement("tk-s3cr3t"))
+			Expect(display).To(ContainElements(locale.RedactedValue, "2"))
+		})
+	})
+
+	Context("given: invalid sensitive value", func() {
+		It("🧪 should: redact value from validation error", func() {
+			err := execute("--token", "s3cr3t")
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).NotTo(ContainSubstring("s3cr3t"))
+			Expect(err.Error()).To(ContainSubstring(locale.RedactedValue))
+
+			structured, ok := err.(locale.StructuredValidationError)
+			Expect(ok).To(BeTrue())
+			Expect(structured.Failure().Value).To(Equal(locale.RedactedValue))
+			Expect(structured.Failure().Flag).To(Equal("token"))
+		})
+
+		It("🧪 should: redact value from parse error", func() {
+			err := execute("--pin", "12ab")
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).NotTo(ContainSubstring("12ab"))
+			Expect(err.Error()).To(ContainSubstring("--pin"))
+		})
+
+		It("🧪 should: not redact value of other flags", func() {
+			err := execute("--depth", "9")
+
+			Expect(err).To(MatchError(ContainSubstring("9")))
+		})
+	})
+
+	Context("given: invalid sensitive value rejected by client validator", func() {
+		It("🧪 should: redact value from validation error", func() {
+			rootCommand := &cobra.Command{
+				Use:          "poke",
+				SilenceUsage: true,
+				RunE: func(_ *cobra.Command, _ []string) error {
+					return paramSet.Validate()
+				},
+			}
+			container = assistant.NewCobraContainer(rootCommand)
+			paramSet = assistant.NewParamSet[SensitiveParameterSet](rootCommand)
+
+			token := assistant.NewFlagInfo("token used to authenticate", "t", "")
+			token.Sensitive = true
+			paramSet.BindValidatedString(token, &paramSet.Native.Token,
+				func(value string, _ *pflag.Flag) error {
+					return fmt.Errorf("token '%v' has been revoked", value)
+				},
+			)
+			container.MustRegisterParamSet("poke-ps", paramSet)
+
+			err := execute("--token", "tk-s3cr3t")
+
+			Expect(err).To(MatchError(ContainSubstring("has been revoked")))
+			Expect(err.Error()).NotTo(ContainSubstring("tk-s3cr3t"))
+			Expect(err.Error()).To(ContainSubstring(locale.RedactedValue))
+		})
+	})
+
+	Context("given: flags just bound", func() {
+		It("🧪 should: mark flags as sensitive", func() {
+			flagSet := container.Root().Flags()
+
+			Expect(assistant.IsSensitive(flagSet.Lookup("token"))).To(BeTrue())
+			Expect(assistant.IsSensitive(flagSet.Lookup("depth"))).To(BeFalse())
+			Expect(assistant.SensitiveFlagNames(flagSet)).To(ConsistOf("token", "t", "pin", "p"))
+			Expect(flagSet.Lookup("token").DefValue).To(Equal(locale.RedactedValue))
+		})
+	})
+
+	Context("given: manifest", func() {
+		It("🧪 should: redact default and mark flag as sensitive", func() {
+			manifest := container.Manifest()
+
+			for _, flag := range manifest.Root.Flags {
+				Expect(flag.Sensitive).To(Equal(flag.Name == "token" || flag.Name == "pin"))
+
+				if flag.Name == "token" {
+					Expect(flag.Default).To(Equal(locale.RedactedValue))
+				}
+			}
+
+			data, err := manifest.Marshal()
+			Expect(err).To(Succeed())
+			Expect(strings.Contains(string(data), "default-token")).To(BeFalse())
+		})
+	})
+
+	Context("given: help", func() {
+		It("🧪 should: not display sensitive default", func() {
+			Expect(execute("--help")).To(Succeed())
+
+			Expect(container.Root().UsageString()).NotTo(ContainSubstring("default-token"))
+		})
+	})
+})
@@ -0,0 +1,152 @@
+package assistant
+
+import (
+	"errors"
+	"maps"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+)
+
+// ShortFlagPolicy allocates the short name of a flag at the point it is
+// bound, allowing the short name requested by the FlagInfo to be overridden.
+// Returning an empty string binds the flag without a short name.
+type ShortFlagPolicy interface {
+	Allocate(info *FlagInfo, flagSet *pflag.FlagSet) string
+}
+
+// ShortFlagPolicyFn is a function that implements ShortFlagPolicy.
+type ShortFlagPolicyFn func(info *FlagInfo, flagSet *pflag.FlagSet) string
+
+func (fn ShortFlagPolicyFn) Allocate(info *FlagInfo, flagSet *pflag.FlagSet) string {
+	return fn(info, flagSet)
+}
+
+// ShortFlagOverrides is a ShortFlagPolicy which maps the long name of a flag
+// to the short name it should be bound with; map to an empty string to bind
+// without a short name. Flags not present retain the short name requested.
+type ShortFlagOverrides map[string]string
+
+func (overrides ShortFlagOverrides) Allocate(info *FlagInfo, _ *pflag.FlagSet) string {
+	if short, found := overrides[info.Name]; found {
+		return short
+	}
+
+	return info.Short
+}
+
+// DropCollidingShortFlags is a ShortFlagPolicy that binds a flag without a
+// short name, if the short name requested is already in use on the flag set.
+var DropCollidingShortFlags = ShortFlagPolicyFn(
+	func(info *FlagInfo, flagSet *pflag.FlagSet) string {
+		if len(info.Short) == 1 && flagSet.ShorthandLookup(info.Short) != nil {
+			return ""
+		}
+
+		return info.Short
+	},
+)
+
+// ComposeShortFlagPolicies creates a ShortFlagPolicy that applies each of
+// the policies in turn, each one seeing the short name allocated by the
+// previous.
+func ComposeShortFlagPolicies(policies ...ShortFlagPolicy) ShortFlagPolicy {
+	return ShortFlagPolicyFn(func(info *FlagInfo, flagSet *pflag.FlagSet) string {
+		allocated := *info
+
+		for _, policy := range policies {
+			allocated.Short = policy.Allocate(&allocated, flagSet)
+		}
+
+		return allocated.Short
+	})
+}
+
+// WithShortFlagPolicy sets the policy used to allocate the short names of all
+// flags subsequently bound by this parameter set, including those bound by
+// parameter sets embedded within it (see Embed), eg store families:
+//
+//	paramSet.WithShortFlagPolicy(assistant.ShortFlagOverrides{
+//		"dry-run": "d",
+//	})
+//	paramSet.Native.BindAll(paramSet)
+func (params *ParamSet[N]) WithShortFlagPolicy(policy ShortFlagPolicy) *ParamSet[N] {
+	params.shortFlags.policy = policy
+
+	return params
+}
+
+type shortFlagPolicyHolder struct {
+	policy ShortFlagPolicy
+}
+
+// allocateShortFlag applies the short flag policy to a flag that is about
+// to be bound.
+func (params *ParamSet[N]) allocateShortFlag(info *FlagInfo, flagSet *pflag.FlagSet) {
+	if params.shortFlags.policy == nil {
+		return
+	}
+
+	info.Short = params.shortFlags.policy.Allocate(info, flagSet)
+}
+
+type shortFlagOwner struct {
+	flag    string
+	command string
+}
+
+// CheckShortFlags reports short flag names used by more than 1 flag that
+// are visible to the same command, taking into account persistent flags
+// inherited from ancestors. This detects collisions that would otherwise
+// result in a panic inside cobra when the command is executed. Each
+// collision is reported as a separate error, naming both owners, joined
+// into a single error. CheckShortFlags is invoked by CobraContainer.Execute.
+func (container *CobraContainer) CheckShortFlags() error {
+	errs := []error{}
+	checkShortFlags(container.root, map[string]shortFlagOwner{}, &errs)
+
+	return errors.Join(errs...)
+}
+
+func checkShortFlags(command *cobra.Command,
+	inherited map[string]shortFlagOwner, errs *[]error,
+) {
+	visible := maps.Clone(inherited)
+	persistent := maps.Clone(inherited)
+
+	check := func(flag *pflag.Flag, persists bool) {
+		if flag.Shorthand == "" {
+			return
+		}
+
+		owner, found := visible[flag.Shorthand]
+
+		switch {
+		case !found:
+			current := shortFlagOwner{flag: flag.Name, command: command.CommandPath()}
+			visible[flag.Shorthand] = current
+
+			if persists {
+				persistent[flag.Shorthand] = current
+			}
+
+		case owner.flag != flag.Name:
+			*errs = append(*errs, locale.NewShortFlagCollisionNativeError(
+				flag.Shorthand, owner.flag, owner.command, flag.Name, command.CommandPath(),
+			))
+		}
+	}
+
+	command.PersistentFlags().VisitAll(func(flag *pflag.Flag) {
+		check(flag, true)
+	})
+	command.Flags().VisitAll(func(flag *pflag.Flag) {
+		check(flag, false)
+	})
+
+	for _, child := range command.Commands() {
+		checkShortFlags(child, persistent, errs)
+	}
+}
@@ -0,0 +1,80 @@
+package assistant_test
+
+import (
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+	"github.com/spf13/cobra"
+
+	"github.com/snivilised/cobrass/src/assistant"
+)
+
+var _ = Describe("CheckShortFlags", func() {
+	var (
+		container     *assistant.CobraContainer
+		widgetCommand *cobra.Command
+	)
+
+	BeforeEach(func() {
+		container = assistant.NewCobraContainer(&cobra.Command{Use: "poke"})
+		container.Root().PersistentFlags().BoolP("verbose", "v", false, "verbose")
+
+		widgetCommand = &cobra.Command{
+			Use: "widget",
+			RunE: func(_ *cobra.Command, _ []string) error {
+				return nil
+			},
+		}
+		container.MustRegisterRootedCommand(widgetCommand)
+	})
+
+	Context("given: no collisions", func() {
+		It("🧪 should: not report error", func() {
+			widgetCommand.Flags().BoolP("offset", "o", false, "offset")
+
+			Expect(container.CheckShortFlags()).To(Succeed())
+		})
+	})
+
+	Context("given: local flag collides with inherited persistent flag", func() {
+		It("🧪 should: report error naming both owners", func() {
+			widgetCommand.Flags().BoolP("version", "v", false, "version")
+
+			err := container.CheckShortFlags()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("'--verbose' (poke)"))
+			Expect(err.Error()).To(ContainSubstring("'--version' (poke widget)"))
+		})
+
+		It("🧪 should: fail execute without panic", func() {
+			widgetCommand.Flags().BoolP("version", "v", false, "version")
+			container.Root().SetArgs([]string{"widget"})
+
+			Expect(container.ExecuteE()).NotTo(Succeed())
+		})
+	})
+
+	Context("given: sibling commands using same short flag", func() {
+		It("🧪 should: not report error", func() {
+			widgetCommand.Flags().BoolP("offset", "o", false, "offset")
+			gadgetCommand := &cobra.Command{Use: "gadget"}
+			container.MustRegisterRootedCommand(gadgetCommand)
+			gadgetCommand.Flags().BoolP("output", "o", false, "output")
+
+			Expect(container.CheckShortFlags()).To(Succeed())
+		})
+	})
+
+	Context("given: short flag overrides", func() {
+		It("🧪 should: bind with overridden short name", func() {
+			paramSet := assistant.NewParamSet[WidgetParameterSet](widgetCommand).
+				WithShortFlagPolicy(assistant.ShortFlagOverrides{"offset": "f"})
+			paramSet.BindInt(
+				assistant.NewFlagInfo("offset is the offset", "v", 0),
+				&paramSet.Native.Offset,
+			)
+
+			Expect(widgetCommand.Flags().Lookup("offset").Shorthand).To(Equal("f"))
+			Expect(container.CheckShortFlags()).To(Succeed())
+		})
+	})
+})
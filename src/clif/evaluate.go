@@ -10,15 +10,141 @@ import (
 
 var booleans = []string{"true", "false"}
 
+// OutputStyle determines the form of the options in the command line
+// composed by Evaluate.
+type OutputStyle int
+
+const (
+	// SeparateOutputStyle the flag and its option value are separate
+	// tokens, eg "--gaussian-blur 0.05" and "-b 0.05". This is the default.
+	SeparateOutputStyle OutputStyle = iota
+
+	// JoinedOutputStyle the flag and its option value are joined into a
+	// single token, eg "--gaussian-blur=0.05" and "-b0.05", for third party
+	// commands that require this form. A single dash long flag, eg
+	// "-interlace plane", can't be joined, so remains separate.
+	JoinedOutputStyle
+)
+
+// Ordering determines the order of the flags in the command line composed
+// by Evaluate. The specified flags are always in the order of their keys,
+// which are sorted for ChangedFlagsMap and MultiValueFlagsMap, but are in
+// the order they occur for OrderedFlags (see EvaluateOrdered).
+type Ordering int
+
+const (
+	// GroupedOrdering the specified flags are followed by the remaining
+	// flags and positional arguments of secondary. This is the default.
+	GroupedOrdering Ordering = iota
+
+	// PreservedOrdering the remaining flags and positional arguments of
+	// secondary retain their original positions, ie a flag that was the
+	// nth flag or argument in secondary is also the nth in the resulting
+	// command line, if there are enough specified flags to precede it. The
+	// specified flags fill the positions in between, in their own order.
+	// This is required by third party commands where the order of the
+	// options is significant, eg ImageMagick, where options are operators
+	// applied in sequence.
+	PreservedOrdering
+)
+
+// positionedGroup the tokens of a flag and its option value, or a
+// positional argument, in secondary and its position, counted in groups.
+type positionedGroup struct {
+	position int
+	tokens   ThirdPartyCommandLine
+}
+
+// arrange composes the command line from the groups of the specified flags
+// and the remaining groups of secondary, according to the ordering.
+func (ordering Ordering) arrange(present []ThirdPartyCommandLine,
+	secondary []positionedGroup,
+) ThirdPartyCommandLine {
+	commandLine := ThirdPartyCommandLine{}
+
+	if ordering != PreservedOrdering {
+		for _, group := range present {
+			commandLine = append(commandLine, group...)
+		}
+
+		for _, group := range secondary {
+			commandLine = append(commandLine, group.tokens...)
+		}
+
+		return commandLine
+	}
+
+	for slot, p, s := 0, 0, 0; p < len(present) || s < len(secondary); slot++ {
+		if s < len(secondary) && (secondary[s].position <= slot || p == len(present)) {
+			commandLine = append(commandLine, secondary[s].tokens...)
+			s++
+
+			continue
+		}
+
+		commandLine = append(commandLine, present[p]...)
+		p++
+	}
+
+	return commandLine
+}
+
+// EvaluateOptions options that determine how Evaluate composes the command
+// line.
+type EvaluateOptions struct {
+	// Style is the form of the options in the composed command line,
+	// defaults to SeparateOutputStyle.
+	//
+	Style OutputStyle
+
+	// Schema describes the flags of the third party command. Without it,
+	// a flag is only paired with the following token as its value, if that
+	// token does not begin with a dash.
+	//
+	Schema ThirdPartySchema
+
+	// Ordering determines the position of the flags in secondary relative
+	// to the specified flags, defaults to GroupedOrdering.
+	//
+	Ordering Ordering
+}
+
+// EvaluateOptionFn definition of a client defined function to set
+// EvaluateOptions.
+type EvaluateOptionFn func(o *EvaluateOptions)
+
+// compose creates the tokens that represent the flag and its option value
+// in the style, the option value being omitted for a switch.
+func (style OutputStyle) compose(lead, bare, optionValue string) []string {
+	if optionValue == "" {
+		return []string{lead + bare}
+	}
+
+	if style == JoinedOutputStyle {
+		switch {
+		case lead == "--":
+			return []string{lead + bare + "=" + optionValue}
+
+		case len(bare) == 1:
+			return []string{lead + bare + optionValue}
+		}
+	}
+
+	return []string{lead + bare, optionValue}
+}
+
 type (
 	tokenInput struct {
 		token        string
 		lead         string
 		bare         string
 		optionValue  string
+		attached     bool
 		existingCL   ThirdPartyCommandLine
-		presentFlags ChangedFlagsMap
+		presentFlags MultiValueFlagsMap
 		knownBy      KnownByCollection
+		style        OutputStyle
+		schema       ThirdPartySchema
 	}
 
 	handleTokenResult struct {
@@ -40,14 +166,21 @@ func (i *tokenInput) consume(nextIndex int, secondaryCL ThirdPartyCommandLine) i
 
 	handleAsPair := false
 
-	if nextIndex < len(secondaryCL) {
+	if !i.attached && nextIndex < len(secondaryCL) {
 		next := secondaryCL[nextIndex]
-		nextLead, nextBare := split(next)
+		nextLead, nextBare, _, _ := split(next, i.knownBy, i.schema)
 
-		if strings.HasPrefix(i.lead, "-") && !strings.HasPrefix(nextLead, "-") {
+		if takes, found := i.schema.takesValue(i.bare, i.knownBy); found && i.lead != "" {
+			i.optionValue = next
+			handleAsPair = takes
+		} else if strings.HasPrefix(i.lead, "-") && !strings.HasPrefix(nextLead, "-") {
 			i.optionValue = nextBare
 			handleAsPair = true
 		}
+
+		if !handleAsPair {
+			i.optionValue = ""
+		}
 	}
 
 	return lo.Ternary(handleAsPair, pairIncrement, unaryIncrement)
@@ -57,10 +190,10 @@ func (i *tokenInput) concatIf(concatFunc concatIfFunc) *concatenateResult {
 	handleResult := concatFunc(i)
 
 	if handleResult.doConcatenate {
-		i.existingCL = append(i.existingCL, i.token)
-
-		if i.optionValue != "" {
-			i.existingCL = append(i.existingCL, i.optionValue)
+		if i.lead == "" {
+			i.existingCL = append(i.existingCL, i.token)
+		} else {
+			i.existingCL = append(i.existingCL, i.style.compose(i.lead, i.bare, i.optionValue)...)
 		}
 	}
 
@@ -99,92 +232,280 @@ func notInPresent(input *tokenInput) *handleTokenResult {
 // form; eg a flag may be in its short from in specified but in long form
 // in secondary. This is resolved by the knownBy set. The specified set
 // contains flags in their bare long form.
+//
+// Options in secondary may be specified with their option value as a
+// separate token, eg "--gaussian-blur 0.05", joined to a long flag with "=",
+// eg "--gaussian-blur=0.05", or attached to a short flag, eg "-b0.05" or
+// "-b=0.05". All options in the resulting command line are normalised to
+// the output style (see EvaluateOptions). The value of a repeatable flag
+// (see ThirdPartySchema) in specified, may contain multiple comma separated
+// values, eg "[a,b]", each of which results in a separate occurrence of the
+// flag. To specify values that may themselves contain a comma, use
+// EvaluateMulti instead.
 func Evaluate(presentFlags ChangedFlagsMap,
 	knownBy KnownByCollection,
 	secondaryCL ThirdPartyCommandLine,
+	options ...EvaluateOptionFn,
+) ThirdPartyCommandLine {
+	option := evaluateOptions(options)
+	multi := option.Schema.multi(presentFlags, knownBy)
+
+	return evaluate(multi, orderedFlags(multi), knownBy, secondaryCL, &option)
+}
+
+// EvaluateMulti is the multi valued form of Evaluate, in which each of the
+// specified flags may have multiple option values, each of which results in
+// a separate occurrence of the flag, eg "-define a=1 -define b=2". How the
+// values of a specified flag are combined with its occurrences in secondary
+// is determined by the merge policy of the flag (see ThirdPartyFlagSpec),
+// which by default replaces them.
+func EvaluateMulti(presentFlags MultiValueFlagsMap,
+	knownBy KnownByCollection,
+	secondaryCL ThirdPartyCommandLine,
+	options ...EvaluateOptionFn,
+) ThirdPartyCommandLine {
+	option := evaluateOptions(options)
+
+	return evaluate(presentFlags, orderedFlags(presentFlags), knownBy, secondaryCL, &option)
+}
+
+// EvaluateOrdered is the form of EvaluateMulti in which the specified flags
+// are the occurrences of the flags in the order the user specified them,
+// which is preserved in the resulting command line, even when the
+// occurrences of a flag are interleaved with those of other flags. Typically
+// used in conjunction with PreservedOrdering (see EvaluateOptions), so that
+// the remaining flags of secondary also retain their positions.
+func EvaluateOrdered(presentFlags OrderedFlags,
+	knownBy KnownByCollection,
+	secondaryCL ThirdPartyCommandLine,
+	options ...EvaluateOptionFn,
 ) ThirdPartyCommandLine {
-	result := &concatenateResult{}
-	bilateralKnownBy := composeBilateral(knownBy)
+	option := evaluateOptions(options)
+
+	return evaluate(presentFlags.multi(), presentFlags, knownBy, secondaryCL, &option)
+}
+
+// multi collects the option values of the occurrences of each flag.
+func (flags OrderedFlags) multi() MultiValueFlagsMap {
+	multi := MultiValueFlagsMap{}
+
+	for _, occurrence := range flags {
+		multi[occurrence.Flag] = append(multi[occurrence.Flag], occurrence.Value)
+	}
+
+	return multi
+}
+
+// orderedFlags spreads the option values of the flags into their
+// occurrences, in the order of the flags.
+func orderedFlags(presentFlags MultiValueFlagsMap) OrderedFlags {
+	ordered := OrderedFlags{}
+
+	for _, flag := range presentFlags.Keys() {
+		for _, value := range presentFlags[flag] {
+			ordered = append(ordered, ThirdPartyFlagOccurrence{Flag: flag, Value: value})
+		}
+	}
+
+	return ordered
+}
 
-	result.commandLine = spreadFlags(presentFlags)
+func evaluateOptions(options []EvaluateOptionFn) EvaluateOptions {
+	option := EvaluateOptions{
+		Style: SeparateOutputStyle,
+	}
 
-	if len(secondaryCL) == 0 {
-		return result.commandLine
+	for _, functionalOption := range options {
+		functionalOption(&option)
 	}
 
-	if len(secondaryCL) == 1 {
-		token := secondaryCL[0]
-		lead, bare := split(token)
+	return option
+}
+
+func evaluate(presentFlags MultiValueFlagsMap,
+	order OrderedFlags,
+	knownBy KnownByCollection,
+	secondaryCL ThirdPartyCommandLine,
+	option *EvaluateOptions,
+) ThirdPartyCommandLine {
+	bilateralKnownBy := recognise(composeBilateral(knownBy), presentFlags.Keys())
+	superseded := occurrences(presentFlags, bilateralKnownBy, secondaryCL, option)
+	present := spreadFlags(order, superseded, option, bilateralKnownBy)
+	secondary := []positionedGroup{}
+
+	for t, n, position := 0, 1, 0; t < len(secondaryCL); position++ {
+		token := secondaryCL[t]
+		lead, bare, optionValue, attached := split(token, bilateralKnownBy, option.Schema)
 
 		input := &tokenInput{
 			token:        token,
 			lead:         lead,
 			bare:         bare,
-			existingCL:   result.commandLine,
+			optionValue:  optionValue,
+			attached:     attached,
 			presentFlags: presentFlags,
 			knownBy:      bilateralKnownBy,
+			style:        option.Style,
+			schema:       option.Schema,
 		}
-		result = input.concatIf(notInPresent)
+		increment := input.consume(n, secondaryCL)
 
-		return result.commandLine
+		if result := input.concatIf(notInPresent); result.handleResult.doConcatenate {
+			secondary = append(secondary, positionedGroup{
+				position: position,
+				tokens:   result.commandLine,
+			})
+		}
+
+		t += increment
+		n += increment
 	}
 
+	return option.Ordering.arrange(present, secondary)
+}
+
+// occurrences collects the option values of the flags in secondary that
+// are also specified, keyed by the specified flag, so that they can be
+// merged according to the merge policy of the flag.
+func occurrences(presentFlags MultiValueFlagsMap,
+	knownBy KnownByCollection,
+	secondaryCL ThirdPartyCommandLine,
+	option *EvaluateOptions,
+) map[ThirdPartyFlagName]ThirdPartyOptionValues {
+	result := map[ThirdPartyFlagName]ThirdPartyOptionValues{}
+
 	for t, n := 0, 1; t < len(secondaryCL); {
-		token := secondaryCL[t]
-		lead, bare := split(token)
+		lead, bare, optionValue, attached := split(secondaryCL[t], knownBy, option.Schema)
 
 		input := &tokenInput{
-			token:        token,
-			lead:         lead,
-			bare:         bare,
-			existingCL:   result.commandLine,
-			presentFlags: presentFlags,
-			knownBy:      bilateralKnownBy,
+			lead:        lead,
+			bare:        bare,
+			optionValue: optionValue,
+			attached:    attached,
+			knownBy:     knownBy,
+			schema:      option.Schema,
 		}
 		increment := input.consume(n, secondaryCL)
-		result = input.concatIf(notInPresent)
+
+		if lead != "" && input.optionValue != "" {
+			flag := bare
+
+			if _, found := presentFlags[flag]; !found {
+				flag = knownBy[bare]
+			}
+
+			if _, found := presentFlags[flag]; found {
+				result[flag] = append(result[flag], input.optionValue)
+			}
+		}
 
 		t += increment
 		n += increment
 	}
 
-	return result.commandLine
+	return result
 }
 
-func split(token string) (string, string) { //nolint:gocritic // pedant
-	var (
-		lead string
-		bare = token
-	)
+// split splits the token into its lead (the leading dashes) and bare name.
+// An option value joined to a long flag with "=", or attached to a short flag,
+// with or without "=", is also split from the token. A single dash token is
+// only regarded as a short flag with an attached value, if the bare token is
+// not itself a known flag, but begins with a known short flag (see attaches),
+// so that single dash long flags, eg "-interlace", are retained intact. A flag
+// is known if it is in knownBy or is described by the schema.
+func split(token string, knownBy KnownByCollection,
+	schema ThirdPartySchema,
+) (lead, bare, optionValue string, attached bool) {
+	bare = token
 
 	if strings.HasPrefix(token, "--") {
 		lead = "--"
 		bare = token[2:]
-	} else if strings.HasPrefix(token, "-") {
+
+		if name, value, found := strings.Cut(bare, "="); found {
+			return lead, name, value, true
+		}
+	} else if strings.HasPrefix(token, "-") && len(token) > 1 {
 		lead = "-"
 		bare = token[1:]
+
+		if attaches(bare, knownBy, schema) {
+			return lead, bare[:1], strings.TrimPrefix(bare[1:], "="), true
+		}
 	}
 
-	return lead, bare
+	return lead, bare, "", false
 }
 
-func spreadFlags(presentFlags ChangedFlagsMap) ThirdPartyCommandLine {
-	commandLine := ThirdPartyCommandLine{}
+// attaches determines whether the bare single dash token is a known short
+// flag with an attached value, eg "q80". Unless the schema determines
+// whether the short flag takes a value, a token consisting entirely of
+// known short flags, eg "sD", is a combination of switches rather than a
+// flag with an attached value.
+func attaches(bare string, knownBy KnownByCollection, schema ThirdPartySchema) bool {
+	known := func(name string) bool {
+		_, inKnownBy := knownBy[name]
+		_, _, inSchema := schema.lookup(name, knownBy)
+
+		return inKnownBy || inSchema
+	}
 
-	for _, flag := range presentFlags.Keys() {
-		option := presentFlags[flag]
+	if len(bare) < 2 || known(bare) || !known(bare[:1]) {
+		return false
+	}
+
+	if takes, found := schema.takesValue(bare[:1], knownBy); found {
+		return takes
+	}
+
+	return !lo.EveryBy(strings.Split(bare, ""), known)
+}
+
+// spreadFlags composes a group per occurrence of the specified flags, in
+// their order. The values in secondary that are retained by the merge policy
+// of a flag, precede its first occurrence.
+func spreadFlags(order OrderedFlags,
+	superseded map[ThirdPartyFlagName]ThirdPartyOptionValues,
+	option *EvaluateOptions,
+	knownBy KnownByCollection,
+) []ThirdPartyCommandLine {
+	groups := make([]ThirdPartyCommandLine, 0, len(order))
+	merged := map[ThirdPartyFlagName]ThirdPartyOptionValues{}
+
+	for _, occurrence := range order {
+		flag := occurrence.Flag
+		_, spec, _ := option.Schema.lookup(flag, knownBy)
+		prior, found := merged[flag]
+		values := ThirdPartyOptionValues{}
+
+		if !found {
+			prior = spec.Merge.merge(superseded[flag], ThirdPartyOptionValues{})
+			values = append(values, prior...)
+		}
+
+		if spec.Merge != UnionMergePolicy || !slices.Contains(prior, occurrence.Value) {
+			prior = append(prior, occurrence.Value)
+			values = append(values, occurrence.Value)
+		}
+
+		merged[flag] = prior
 		dash := lo.Ternary(len(flag) == 1, "-", "--")
-		prefixed := dash + flag
-		withOption := !slices.Contains(booleans, option)
+		group := ThirdPartyCommandLine{}
 
-		commandLine = append(commandLine, prefixed)
+		for _, value := range values {
+			withOption := !slices.Contains(booleans, value)
 
-		if withOption {
-			commandLine = append(commandLine, option)
+			group = append(group,
+				option.Style.compose(dash, flag, lo.Ternary(withOption, value, ""))...,
+			)
+		}
+
+		if len(group) > 0 {
+			groups = append(groups, group)
 		}
 	}
 
-	return commandLine
+	return groups
 }
 
 func composeBilateral(knownBy KnownByCollection) KnownByCollection {
@@ -199,3 +520,17 @@ func composeBilateral(knownBy KnownByCollection) KnownByCollection {
 
 	return bilateral
 }
+
+// recognise adds the flags that are not already known, to the bilateral
+// knownBy collection without a short name, so that a single dash long flag
+// that is present, eg "-fill", is not mistaken for a known short flag with
+// an attached value, eg "-f ill".
+func recognise(bilateralKnownBy KnownByCollection, flags []ThirdPartyFlagName) KnownByCollection {
+	for _, flag := range flags {
+		if _, known := bilateralKnownBy[flag]; !known {
+			bilateralKnownBy[flag] = ""
+		}
+	}
+
+	return bilateralKnownBy
+}
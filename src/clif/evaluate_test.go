@@ -13,6 +13,45 @@ type evaluateTE struct {
 	baseTE
 	specified clif.ChangedFlagsMap
 	secondary clif.ThirdPartyCommandLine
+	style     clif.OutputStyle
+	schema    clif.ThirdPartySchema
+}
+
+type evaluateMultiTE struct {
+	baseTE
+	specified clif.MultiValueFlagsMap
+	secondary clif.ThirdPartyCommandLine
+	merge     clif.MergePolicy
+}
+
+type evaluateOrderedTE struct {
+	baseTE
+	specified []string
+	secondary clif.ThirdPartyCommandLine
+	ordering  clif.Ordering
+}
+
+// ordered creates the ordered flags from alternating flags and values
+func ordered(pairs []string) clif.OrderedFlags {
+	flags := clif.OrderedFlags{}
+
+	for i := 0; i+1 < len(pairs); i += 2 {
+		flags = append(flags, clif.ThirdPartyFlagOccurrence{Flag: pairs[i], Value: pairs[i+1]})
+	}
+
+	return flags
+}
+
+var imageSchema = clif.ThirdPartySchema{
+	"dry-run":         {Kind: clif.SwitchFlagKind},
+	"strip":           {Kind: clif.SwitchFlagKind},
+	"quality":         {Kind: clif.ValueFlagKind},
+	"offset":          {Kind: clif.ValueFlagKind},
+	"pattern":         {Kind: clif.ValueFlagKind},
+	"sampling-factor": {Kind: clif.ValueFlagKind},
+	"define":          {Kind: clif.RepeatableFlagKind},
+	"fill":            {Kind: clif.RepeatableFlagKind},
+	"draw":            {Kind: clif.RepeatableFlagKind},
 }
 
 var _ = Describe("Evaluate", Ordered, func() {
@@ -31,7 +70,12 @@ var _ = Describe("Evaluate", Ordered, func() {
 
 	DescribeTable("ThirdPartyCommandLine",
 		func(entry *evaluateTE) {
-			actual := clif.Evaluate(entry.specified, knownBy, entry.secondary)
+			actual := clif.Evaluate(entry.specified, knownBy, entry.secondary,
+				func(o *clif.EvaluateOptions) {
+					o.Style = entry.style
+					o.Schema = entry.schema
+				},
+			)
 			Expect(actual).To(HaveExactElements(entry.expected))
 		},
 		func(entry *evaluateTE) string {
@@ -273,5 +317,369 @@ var _ = Describe("Evaluate", Ordered, func() {
 				"-D", "-f", "2x1", "--strip", "--gaussian-blur", "0.15", "--interlace", "line",
 			},
 		}),
+
+		// joined and attached option values
+		//
+		Entry(nil, &evaluateTE{
+			baseTE: baseTE{
+				given:        "secondary long flag joined with value; flag in specified",
+				shouldReturn: "specified, ignore secondary",
+				expected:     []string{"--gaussian-blur", "0.05", "--strip"},
+			},
+			specified: clif.ChangedFlagsMap{
+				"gaussian-blur": "0.05",
+			},
+			secondary: clif.ThirdPartyCommandLine{"--gaussian-blur=0.15", "--strip"},
+		}),
+
+		Entry(nil, &evaluateTE{
+			baseTE: baseTE{
+				given:        "secondary short flags with attached values; not in specified",
+				shouldReturn: "specified, with normalised secondary",
+				expected:     []string{"--dry-run", "-b", "0.15", "-q", "80", "-s"},
+			},
+			specified: clif.ChangedFlagsMap{
+				"dry-run": "true",
+			},
+			secondary: clif.ThirdPartyCommandLine{"-b0.15", "-q=80", "-s"},
+		}),
+
+		Entry(nil, &evaluateTE{
+			baseTE: baseTE{
+				given:        "secondary short flag with attached value; long form in specified",
+				shouldReturn: "specified, ignore secondary",
+				expected:     []string{"--quality", "90", "-s"},
+			},
+			specified: clif.ChangedFlagsMap{
+				"quality": "90",
+			},
+			secondary: clif.ThirdPartyCommandLine{"-q80", "-s"},
+		}),
+
+		Entry(nil, &evaluateTE{
+			baseTE: baseTE{
+				given:        "secondary unknown single dash long flags",
+				shouldReturn: "specified, with intact secondary",
+				expected:     []string{"--dry-run", "-density", "300", "-define", "a=1", "-colorspace", "sRGB"},
+			},
+			specified: clif.ChangedFlagsMap{
+				"dry-run": "true",
+			},
+			secondary: clif.ThirdPartyCommandLine{"-density", "300", "-define", "a=1", "-colorspace", "sRGB"},
+		}),
+
+		Entry(nil, &evaluateTE{
+			baseTE: baseTE{
+				given:        "secondary combined short switches",
+				shouldReturn: "specified, with intact secondary",
+				expected:     []string{"--quality", "90", "file.jpg", "-sD"},
+			},
+			specified: clif.ChangedFlagsMap{
+				"quality": "90",
+			},
+			secondary: clif.ThirdPartyCommandLine{"file.jpg", "-sD"},
+		}),
+
+		Entry(nil, &evaluateTE{
+			baseTE: baseTE{
+				given:        "secondary single dash long flag beginning with short name; in specified",
+				shouldReturn: "specified, ignore secondary",
+				expected:     []string{"--fill", "red", "in.png"},
+			},
+			specified: clif.ChangedFlagsMap{
+				"fill": "red",
+			},
+			secondary: clif.ThirdPartyCommandLine{"-fill", "green", "in.png"},
+		}),
+
+		Entry(nil, &evaluateTE{
+			baseTE: baseTE{
+				given:        "joined output style",
+				shouldReturn: "options joined with their values",
+				expected: []string{
+					"--dry-run", "--gaussian-blur=0.05", "-iplane", "--sampling-factor=2x1", "-q80", "file.jpg",
+				},
+			},
+			specified: clif.ChangedFlagsMap{
+				"gaussian-blur": "0.05",
+				"i":             "plane",
+				"dry-run":       "true",
+			},
+			secondary: clif.ThirdPartyCommandLine{
+				"--sampling-factor", "2x1", "-q", "80", "--gaussian-blur=0.15", "file.jpg",
+			},
+			style: clif.JoinedOutputStyle,
+		}),
+
+		Entry(nil, &evaluateTE{
+			baseTE: baseTE{
+				given:        "joined output style; secondary single dash long flag",
+				shouldReturn: "single dash long flag separate from its value",
+				expected:     []string{"-q90", "-interlace", "plane", "--sampling-factor=2x1"},
+			},
+			specified: clif.ChangedFlagsMap{
+				"q": "90",
+			},
+			secondary: clif.ThirdPartyCommandLine{"-interlace", "plane", "--sampling-factor", "2x1"},
+			style:     clif.JoinedOutputStyle,
+		}),
+		//
+		// end: joined and attached option values
+
+		// schema driven pairing
+		//
+		Entry(nil, &evaluateTE{
+			baseTE: baseTE{
+				given:        "no schema; secondary value begins with dash",
+				shouldReturn: "value mistaken for a flag",
+				expected:     []string{"--dry-run", "--offset", "-5"},
+			},
+			specified: clif.ChangedFlagsMap{
+				"dry-run": "true",
+			},
+			secondary: clif.ThirdPartyCommandLine{"--offset", "-5"},
+		}),
+
+		Entry(nil, &evaluateTE{
+			baseTE: baseTE{
+				given:        "schema; secondary negative number value",
+				shouldReturn: "flag paired with negative value",
+				expected:     []string{"--quality", "90", "--offset", "-5", "--pattern", "-foo"},
+			},
+			specified: clif.ChangedFlagsMap{
+				"quality": "90",
+			},
+			secondary: clif.ThirdPartyCommandLine{"-q", "-1", "--offset", "-5", "--pattern", "-foo"},
+			schema:    imageSchema,
+		}),
+
+		Entry(nil, &evaluateTE{
+			baseTE: baseTE{
+				given:        "schema; secondary switch followed by positional",
+				shouldReturn: "switch not paired with positional",
+				expected:     []string{"--quality", "90", "--strip", "file.jpg"},
+			},
+			specified: clif.ChangedFlagsMap{
+				"quality": "90",
+			},
+			secondary: clif.ThirdPartyCommandLine{"--strip", "file.jpg"},
+			schema:    imageSchema,
+		}),
+
+		Entry(nil, &evaluateTE{
+			baseTE: baseTE{
+				given:        "schema; repeatable flag in specified",
+				shouldReturn: "occurrence per value, ignore secondary",
+				expected:     []string{"--define", "a=1", "--define", "b=-2", "--strip"},
+			},
+			specified: clif.ChangedFlagsMap{
+				"define": "[a=1,b=-2]",
+			},
+			secondary: clif.ThirdPartyCommandLine{"--define", "c=3", "--strip", "--define", "-d=4"},
+			schema:    imageSchema,
+		}),
+
+		Entry(nil, &evaluateTE{
+			baseTE: baseTE{
+				given:        "schema; repeatable flag in secondary",
+				shouldReturn: "all occurrences of secondary",
+				expected:     []string{"--quality", "90", "--define", "c=3", "--define", "-d=4"},
+			},
+			specified: clif.ChangedFlagsMap{
+				"quality": "90",
+			},
+			secondary: clif.ThirdPartyCommandLine{"--define", "c=3", "--define", "-d=4"},
+			schema:    imageSchema,
+		}),
+
+		Entry(nil, &evaluateTE{
+			baseTE: baseTE{
+				given:        "schema; secondary single dash long flags beginning with short name",
+				shouldReturn: "specified, with intact secondary",
+				expected:     []string{"--quality", "90", "-sharpen", "0x1", "-blur", "2", "-sD"},
+			},
+			specified: clif.ChangedFlagsMap{
+				"quality": "90",
+			},
+			secondary: clif.ThirdPartyCommandLine{"-sharpen", "0x1", "-quality", "85", "-blur", "2", "-sD"},
+			schema: clif.ThirdPartySchema{
+				"quality": {Kind: clif.ValueFlagKind},
+				"strip":   {Kind: clif.SwitchFlagKind},
+				"sharpen": {Kind: clif.ValueFlagKind},
+				"blur":    {Kind: clif.ValueFlagKind},
+			},
+		}),
+		//
+		// end: schema driven pairing
+	)
+
+	DescribeTable("EvaluateMulti",
+		func(entry *evaluateMultiTE) {
+			schema := clif.ThirdPartySchema{
+				"strip":           {Kind: clif.SwitchFlagKind},
+				"define":          {Kind: clif.RepeatableFlagKind, Merge: entry.merge},
+				"sampling-factor": {Kind: clif.RepeatableFlagKind, Merge: entry.merge},
+			}
+			actual := clif.EvaluateMulti(entry.specified, knownBy, entry.secondary,
+				func(o *clif.EvaluateOptions) {
+					o.Schema = schema
+				},
+			)
+			Expect(actual).To(HaveExactElements(entry.expected))
+		},
+		func(entry *evaluateMultiTE) string {
+			return fmt.Sprintf("🧪 ===> given: '%v', should: return '%v'",
+				entry.given, entry.shouldReturn,
+			)
+		},
+
+		Entry(nil, &evaluateMultiTE{
+			baseTE: baseTE{
+				given:        "values containing comma",
+				shouldReturn: "occurrence per value",
+				expected:     []string{"--define", "x=1,2", "--define", "y=3", "--strip"},
+			},
+			specified: clif.MultiValueFlagsMap{
+				"define": clif.ThirdPartyOptionValues{"x=1,2", "y=3"},
+			},
+			secondary: clif.ThirdPartyCommandLine{"--strip"},
+		}),
+
+		Entry(nil, &evaluateMultiTE{
+			baseTE: baseTE{
+				given:        "replace merge policy",
+				shouldReturn: "specified values only",
+				expected:     []string{"--define", "a=1", "--define", "b=2", "--strip"},
+			},
+			specified: clif.MultiValueFlagsMap{
+				"define": clif.ThirdPartyOptionValues{"a=1", "b=2"},
+			},
+			secondary: clif.ThirdPartyCommandLine{"--define", "c=3", "--strip", "--define", "a=1"},
+			merge:     clif.ReplaceMergePolicy,
+		}),
+
+		Entry(nil, &evaluateMultiTE{
+			baseTE: baseTE{
+				given:        "append merge policy",
+				shouldReturn: "secondary values followed by specified",
+				expected: []string{
+					"--define", "c=3", "--define", "a=1", "--define", "a=1", "--define", "b=2", "--strip",
+				},
+			},
+			specified: clif.MultiValueFlagsMap{
+				"define": clif.ThirdPartyOptionValues{"a=1", "b=2"},
+			},
+			secondary: clif.ThirdPartyCommandLine{"--define", "c=3", "--strip", "--define=a=1"},
+			merge:     clif.AppendMergePolicy,
+		}),
+
+		Entry(nil, &evaluateMultiTE{
+			baseTE: baseTE{
+				given:        "union merge policy",
+				shouldReturn: "secondary values followed by specified, less duplicates",
+				expected:     []string{"--define", "c=3", "--define", "a=1", "--define", "b=2", "--strip"},
+			},
+			specified: clif.MultiValueFlagsMap{
+				"define": clif.ThirdPartyOptionValues{"a=1", "b=2"},
+			},
+			secondary: clif.ThirdPartyCommandLine{"--define", "c=3", "--strip", "--define", "a=1"},
+			merge:     clif.UnionMergePolicy,
+		}),
+
+		Entry(nil, &evaluateMultiTE{
+			baseTE: baseTE{
+				given:        "union merge policy; secondary in short form",
+				shouldReturn: "merged in long form",
+				expected: []string{
+					"--sampling-factor", "4:2:0", "--sampling-factor", "4:4:4", "file.jpg",
+				},
+			},
+			specified: clif.MultiValueFlagsMap{
+				"sampling-factor": clif.ThirdPartyOptionValues{"4:2:0", "4:4:4"},
+			},
+			secondary: clif.ThirdPartyCommandLine{"-f", "4:2:0", "file.jpg"},
+			merge:     clif.UnionMergePolicy,
+		}),
+	)
+
+	DescribeTable("EvaluateOrdered",
+		func(entry *evaluateOrderedTE) {
+			actual := clif.EvaluateOrdered(ordered(entry.specified), knownBy, entry.secondary,
+				func(o *clif.EvaluateOptions) {
+					o.Schema = imageSchema
+					o.Ordering = entry.ordering
+				},
+			)
+			Expect(actual).To(HaveExactElements(entry.expected))
+		},
+		func(entry *evaluateOrderedTE) string {
+			return fmt.Sprintf("🧪 ===> given: '%v', should: return '%v'",
+				entry.given, entry.shouldReturn,
+			)
+		},
+
+		Entry(nil, &evaluateOrderedTE{
+			baseTE: baseTE{
+				given:        "grouped ordering",
+				shouldReturn: "specified in user order, followed by secondary",
+				expected:     []string{"--quality", "80", "--define", "a=1", "--strip", "--offset", "-5"},
+			},
+			specified: []string{"quality", "80", "define", "a=1"},
+			secondary: clif.ThirdPartyCommandLine{"--strip", "--offset", "-5"},
+			ordering:  clif.GroupedOrdering,
+		}),
+
+		Entry(nil, &evaluateOrderedTE{
+			baseTE: baseTE{
+				given:        "preserved ordering",
+				shouldReturn: "secondary in original positions, specified in between",
+				expected: []string{
+					"--strip", "--quality", "80", "--sampling-factor", "4:2:0", "--define", "a=1",
+				},
+			},
+			specified: []string{"quality", "80", "define", "a=1"},
+			secondary: clif.ThirdPartyCommandLine{
+				"--strip", "-q", "90", "--sampling-factor", "4:2:0",
+			},
+			ordering: clif.PreservedOrdering,
+		}),
+
+		Entry(nil, &evaluateOrderedTE{
+			baseTE: baseTE{
+				given:        "preserved ordering; insufficient specified",
+				shouldReturn: "secondary in original positions, followed by specified",
+				expected:     []string{"in.jpg", "--strip", "out.jpg", "--define", "a=1", "--define", "b=2"},
+			},
+			specified: []string{"define", "a=1", "define", "b=2"},
+			secondary: clif.ThirdPartyCommandLine{"in.jpg", "--strip", "out.jpg"},
+			ordering:  clif.PreservedOrdering,
+		}),
+
+		Entry(nil, &evaluateOrderedTE{
+			baseTE: baseTE{
+				given:        "preserved ordering; leading flag in secondary superseded",
+				shouldReturn: "specified fill the position of superseded flag",
+				expected:     []string{"--quality", "80", "--offset", "-5", "file.jpg", "--strip"},
+			},
+			specified: []string{"quality", "80", "strip", "true"},
+			secondary: clif.ThirdPartyCommandLine{"--strip", "--offset", "-5", "file.jpg"},
+			ordering:  clif.PreservedOrdering,
+		}),
+
+		Entry(nil, &evaluateOrderedTE{
+			baseTE: baseTE{
+				given:        "preserved ordering; interleaved repeated flags",
+				shouldReturn: "occurrences in user order",
+				expected: []string{
+					"in.jpg", "--fill", "red", "--draw", "circle 5,5 9,9",
+					"--fill", "blue", "--draw", "line 0,0 9,9",
+				},
+			},
+			specified: []string{
+				"fill", "red", "draw", "circle 5,5 9,9", "fill", "blue", "draw", "line 0,0 9,9",
+			},
+			secondary: clif.ThirdPartyCommandLine{"in.jpg", "--fill", "green"},
+			ordering:  clif.PreservedOrdering,
+		}),
 	)
 })
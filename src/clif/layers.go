@@ -0,0 +1,261 @@
+package clif
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/snivilised/cobrass/src/internal/third/lo"
+)
+
+// ThirdPartyLayer is a named source of flags for a third party command, eg
+// defaults, profile config, scheme config, environment or the command line.
+// A layer may contain flags in their bare form, eg those collected from the
+// command line, a command line, eg loaded from config, or both, in which case
+// the flags precede the command line.
+type ThirdPartyLayer struct {
+	// Name identifies the layer in the provenance of the tokens composed by
+	// EvaluateLayers, eg "profile".
+	//
+	Name string
+
+	// Flags are the occurrences of the flags of the layer, by their bare
+	// name, in the order they were specified. As per ChangedFlagsMap, a
+	// switch has a true/false option value, a false switch negating the
+	// switch in the layers that precede it.
+	//
+	Flags OrderedFlags
+
+	// CommandLine are the flags and positional arguments of the layer.
+	//
+	CommandLine ThirdPartyCommandLine
+}
+
+// TokenProvenance identifies where a token in the command line composed by
+// EvaluateLayers came from.
+type TokenProvenance struct {
+	// Token is the token in the command line.
+	//
+	Token string
+
+	// Layer is the name of the layer the token came from.
+	//
+	Layer string
+
+	// Flag is the bare long name of the flag the token belongs to, which is
+	// empty for a positional argument.
+	//
+	Flag ThirdPartyFlagName
+}
+
+func (p TokenProvenance) String() string {
+	if p.Flag == "" {
+		return fmt.Sprintf("%v (layer: %v)", p.Token, p.Layer)
+	}
+
+	return fmt.Sprintf("%v (layer: %v, flag: %v)", p.Token, p.Layer, p.Flag)
+}
+
+// LayeredCommandLine is the command line composed by EvaluateLayers, along
+// with the provenance of each of its tokens.
+type LayeredCommandLine struct {
+	// CommandLine is the merged command line.
+	//
+	CommandLine ThirdPartyCommandLine
+
+	// Provenance is parallel to CommandLine, ie Provenance[i] identifies
+	// where CommandLine[i] came from.
+	//
+	Provenance []TokenProvenance
+}
+
+// Explain returns a line per token in the command line, identifying where
+// it came from, for diagnostic purposes.
+func (l *LayeredCommandLine) Explain() []string {
+	lines := make([]string, 0, len(l.Provenance))
+
+	for _, p := range l.Provenance {
+		lines = append(lines, p.String())
+	}
+
+	return lines
+}
+
+type (
+	// layerValue is an option value of a flag, along with the form of the
+	// flag it was specified with and the layer it came from. A negated
+	// value is a switch that has been turned off, so is not composed.
+	layerValue struct {
+		lead    string
+		bare    string
+		value   ThirdPartyOptionValue
+		layer   string
+		negated bool
+	}
+
+	// layerEntry is a flag, or positional argument, of the merged command
+	// line. A positional argument has an empty flag and a single value.
+	layerEntry struct {
+		flag   ThirdPartyFlagName
+		values []layerValue
+	}
+)
+
+// EvaluateLayers merges the layers, which are in ascending order of
+// precedence, ie a flag in a layer takes precedence over the same flag in
+// the layers that precede it. How the values of a flag are combined with
+// those of the preceding layers is determined by its merge policy (see
+// ThirdPartyFlagSpec), which by default replaces them. A flag retains the
+// position at which it first occurs, so the order of the layers' flags is
+// preserved as far as possible and positional arguments are retained in
+// the order they occur in the layers. Flags in either long or short form
+// are resolved by knownBy and retain the form of the layer they came from.
+// A switch that is false in the flags of a layer is turned off, so it is
+// omitted from the command line, unless it is turned on again by a higher
+// layer. The Style and Schema of the options apply as per Evaluate, but
+// Ordering does not.
+func EvaluateLayers(layers []ThirdPartyLayer,
+	knownBy KnownByCollection,
+	options ...EvaluateOptionFn,
+) *LayeredCommandLine {
+	option := evaluateOptions(options)
+	flags := []ThirdPartyFlagName{}
+
+	for _, layer := range layers {
+		for _, occurrence := range layer.Flags {
+			flags = append(flags, occurrence.Flag)
+		}
+	}
+
+	bilateralKnownBy := recognise(composeBilateral(knownBy), flags)
+	merged := []*layerEntry{}
+	indices := map[ThirdPartyFlagName]int{}
+
+	for _, layer := range layers {
+		for _, entry := range layer.entries(knownBy, bilateralKnownBy, &option) {
+			index, found := indices[entry.flag]
+
+			if entry.flag == "" || !found {
+				if entry.flag != "" {
+					indices[entry.flag] = len(merged)
+				}
+
+				merged = append(merged, entry)
+
+				continue
+			}
+
+			_, spec, _ := option.Schema.lookup(entry.flag, bilateralKnownBy)
+			merged[index].values = spec.Merge.mergeLayer(merged[index].values, entry.values)
+		}
+	}
+
+	result := &LayeredCommandLine{
+		CommandLine: ThirdPartyCommandLine{},
+		Provenance:  []TokenProvenance{},
+	}
+
+	for _, entry := range merged {
+		for _, v := range entry.values {
+			if v.negated {
+				continue
+			}
+
+			tokens := lo.Ternary(entry.flag == "",
+				[]string{v.value}, option.Style.compose(v.lead, v.bare, v.value),
+			)
+
+			for _, token := range tokens {
+				result.CommandLine = append(result.CommandLine, token)
+				result.Provenance = append(result.Provenance, TokenProvenance{
+					Token: token,
+					Layer: v.layer,
+					Flag:  entry.flag,
+				})
+			}
+		}
+	}
+
+	return result
+}
+
+// entries returns the flags and positional arguments of the layer, in the
+// order in which they occur, the values of each flag being collected into
+// the entry of its first occurrence.
+func (layer *ThirdPartyLayer) entries(knownBy, bilateralKnownBy KnownByCollection,
+	option *EvaluateOptions,
+) []*layerEntry {
+	entries := []*layerEntry{}
+	indices := map[ThirdPartyFlagName]int{}
+
+	// a positional argument has no lead and is denoted by bare
+	//
+	add := func(lead, bare, value string, negated bool) {
+		if lead == "" {
+			entries = append(entries, &layerEntry{
+				values: []layerValue{{value: bare, layer: layer.Name}},
+			})
+
+			return
+		}
+
+		flag := canonical(bare, knownBy, bilateralKnownBy)
+		index, found := indices[flag]
+
+		if !found {
+			index = len(entries)
+			indices[flag] = index
+			entries = append(entries, &layerEntry{flag: flag})
+		}
+
+		entries[index].values = append(entries[index].values, layerValue{
+			lead:    lead,
+			bare:    bare,
+			value:   value,
+			layer:   layer.Name,
+			negated: negated,
+		})
+	}
+
+	for _, occurrence := range layer.Flags {
+		add(lo.Ternary(len(occurrence.Flag) == 1, "-", "--"), occurrence.Flag,
+			lo.Ternary(slices.Contains(booleans, occurrence.Value), "", occurrence.Value),
+			occurrence.Value == "false",
+		)
+	}
+
+	for t, n := 0, 1; t < len(layer.CommandLine); {
+		token := layer.CommandLine[t]
+		lead, bare, optionValue, attached := split(token, bilateralKnownBy, option.Schema)
+
+		input := &tokenInput{
+			lead:        lead,
+			bare:        bare,
+			optionValue: optionValue,
+			attached:    attached,
+			knownBy:     bilateralKnownBy,
+			schema:      option.Schema,
+		}
+		increment := input.consume(n, layer.CommandLine)
+
+		add(lead, bare, input.optionValue, false)
+
+		t += increment
+		n += increment
+	}
+
+	return entries
+}
+
+// canonical returns the bare long name of the flag, which may be specified
+// by its short name.
+func canonical(bare string, knownBy, bilateralKnownBy KnownByCollection) ThirdPartyFlagName {
+	if _, isLong := knownBy[bare]; isLong {
+		return bare
+	}
+
+	if long, found := bilateralKnownBy[bare]; found && long != "" {
+		return long
+	}
+
+	return bare
+}
@@ -0,0 +1,191 @@
+package clif_test
+
+import (
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+
+	"github.com/snivilised/cobrass/src/clif"
+)
+
+var _ = Describe("EvaluateLayers", func() {
+	var (
+		knownBy clif.KnownByCollection
+		schema  clif.ThirdPartySchema
+		layers  []clif.ThirdPartyLayer
+	)
+
+	BeforeEach(func() {
+		knownBy = clif.KnownByCollection{
+			"quality": "q",
+			"strip":   "s",
+		}
+		schema = clif.ThirdPartySchema{
+			"quality": {Kind: clif.ValueFlagKind},
+			"strip":   {Kind: clif.SwitchFlagKind},
+			"offset":  {Kind: clif.ValueFlagKind},
+			"define":  {Kind: clif.RepeatableFlagKind, Merge: clif.AppendMergePolicy},
+			"tag":     {Kind: clif.RepeatableFlagKind, Merge: clif.UnionMergePolicy},
+			"sharpen": {Kind: clif.ValueFlagKind},
+		}
+
+		cli := clif.OrderedFlags{
+			{Flag: "strip", Value: "true"},
+			{Flag: "offset", Value: "-5"},
+		}
+
+		layers = []clif.ThirdPartyLayer{
+			{
+				Name:        "defaults",
+				CommandLine: clif.ThirdPartyCommandLine{"--quality", "75", "--strip", "in.jpg", "--tag", "x"},
+			},
+			{
+				Name:        "profile",
+				CommandLine: clif.ThirdPartyCommandLine{"-q", "85", "--define", "a=1", "--tag", "y"},
+			},
+			{
+				Name:        "environment",
+				CommandLine: clif.ThirdPartyCommandLine{"--define=b=2", "--tag", "x"},
+			},
+			{
+				Name:  "cli",
+				Flags: cli,
+			},
+		}
+	})
+
+	Context("given: multiple layers", func() {
+		It("🧪 should: merge layers in order of precedence", func() {
+			actual := clif.EvaluateLayers(layers, knownBy, func(o *clif.EvaluateOptions) {
+				o.Schema = schema
+			})
+
+			Expect(actual.CommandLine).To(HaveExactElements(
+				"-q", "85", "--strip", "in.jpg", "--tag", "x", "--tag", "y",
+				"--define", "a=1", "--define", "b=2", "--offset", "-5",
+			))
+			Expect(actual.Provenance).To(HaveLen(len(actual.CommandLine)))
+		})
+
+		It("🧪 should: identify the layer of each token", func() {
+			actual := clif.EvaluateLayers(layers, knownBy, func(o *clif.EvaluateOptions) {
+				o.Schema = schema
+			})
+
+			layerNames := []string{}
+			for _, p := range actual.Provenance {
+				layerNames = append(layerNames, p.Layer)
+			}
+
+			Expect(layerNames).To(HaveExactElements(
+				"profile", "profile", "cli", "defaults", "defaults", "defaults", "profile", "profile",
+				"profile", "profile", "environment", "environment", "cli", "cli",
+			))
+			Expect(actual.Provenance[0].Flag).To(Equal("quality"))
+			Expect(actual.Provenance[3].Flag).To(BeEmpty())
+		})
+
+		It("🧪 should: explain the origin of each token", func() {
+			actual := clif.EvaluateLayers(layers[:2], knownBy, func(o *clif.EvaluateOptions) {
+				o.Schema = schema
+				o.Style = clif.JoinedOutputStyle
+			})
+
+			Expect(actual.CommandLine).To(HaveExactElements(
+				"-q85", "--strip", "in.jpg", "--tag=x", "--tag=y", "--define=a=1",
+			))
+			Expect(actual.Explain()).To(HaveExactElements(
+				"-q85 (layer: profile, flag: quality)",
+				"--strip (layer: defaults, flag: strip)",
+				"in.jpg (layer: defaults)",
+				"--tag=x (layer: defaults, flag: tag)",
+				"--tag=y (layer: profile, flag: tag)",
+				"--define=a=1 (layer: profile, flag: define)",
+			))
+		})
+	})
+
+	Context("given: switch turned off by higher layer", func() {
+		It("🧪 should: omit switch", func() {
+			actual := clif.EvaluateLayers([]clif.ThirdPartyLayer{
+				{
+					Name:        "defaults",
+					CommandLine: clif.ThirdPartyCommandLine{"--strip", "--quality", "75"},
+				},
+				{
+					Name:  "cli",
+					Flags: clif.OrderedFlags{{Flag: "strip", Value: "false"}},
+				},
+			}, knownBy, func(o *clif.EvaluateOptions) {
+				o.Schema = schema
+			})
+
+			Expect(actual.CommandLine).To(HaveExactElements("--quality", "75"))
+			Expect(actual.Explain()).To(HaveExactElements(
+				"--quality (layer: defaults, flag: quality)",
+				"75 (layer: defaults, flag: quality)",
+			))
+		})
+	})
+
+	Context("given: single dash long flags", func() {
+		var single []clif.ThirdPartyLayer
+
+		BeforeEach(func() {
+			single = []clif.ThirdPartyLayer{
+				{
+					Name:        "config",
+					CommandLine: clif.ThirdPartyCommandLine{"-quality", "85", "-interlace", "plane", "-define", "a=1"},
+				},
+				{
+					Name:  "cli",
+					Flags: clif.OrderedFlags{{Flag: "quality", Value: "90"}},
+				},
+			}
+		})
+
+		It("🧪 should: replace known flag and retain unknown flags intact", func() {
+			actual := clif.EvaluateLayers(single, knownBy)
+
+			Expect(actual.CommandLine).To(HaveExactElements(
+				"--quality", "90", "-interlace", "plane", "-define", "a=1",
+			))
+			Expect(actual.Provenance[0].Layer).To(Equal("cli"))
+			Expect(actual.Provenance[2].Flag).To(Equal("interlace"))
+		})
+
+		It("🧪 should: recognise flags of other layers", func() {
+			single[1].Flags = append(single[1].Flags, clif.ThirdPartyFlagOccurrence{
+				Flag: "sharpen", Value: "1x2",
+			})
+			single[0].CommandLine = append(single[0].CommandLine, "-sharpen", "0x1")
+
+			actual := clif.EvaluateLayers(single, knownBy)
+
+			Expect(actual.CommandLine).To(HaveExactElements(
+				"--quality", "90", "-interlace", "plane", "-define", "a=1", "--sharpen", "1x2",
+			))
+		})
+
+		It("🧪 should: recognise flags only in schema", func() {
+			single[0].CommandLine = append(single[0].CommandLine, "-sharpen", "0x1")
+
+			actual := clif.EvaluateLayers(single, knownBy, func(o *clif.EvaluateOptions) {
+				o.Schema = schema
+			})
+
+			Expect(actual.CommandLine).To(HaveExactElements(
+				"--quality", "90", "-interlace", "plane", "-define", "a=1", "-sharpen", "0x1",
+			))
+			Expect(actual.Provenance[6].Flag).To(Equal("sharpen"))
+		})
+	})
+
+	Context("given: no layers", func() {
+		It("🧪 should: return empty command line", func() {
+			actual := clif.EvaluateLayers(nil, knownBy)
+
+			Expect(actual.CommandLine).To(BeEmpty())
+			Expect(actual.Provenance).To(BeEmpty())
+		})
+	})
+})
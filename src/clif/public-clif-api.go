@@ -21,6 +21,10 @@ type (
 	// using the value's String() method.
 	ThirdPartyOptionValue = string
 
+	// ThirdPartyOptionValues the option values of a flag that may be
+	// specified multiple times.
+	ThirdPartyOptionValues = []ThirdPartyOptionValue
+
 	// ChangedFlagsMap represents the set of third party flags
 	// presented by the user on the command line.
 	// (NB: Cobra does not currently have a mechanism to collect third
@@ -32,6 +36,30 @@ type (
 	// but not in ThirdPartyCommandLine.
 	ChangedFlagsMap = collections.OrderedKeysMap[ThirdPartyFlagName, ThirdPartyOptionValue]
 
+	// MultiValueFlagsMap is the multi valued form of ChangedFlagsMap, in
+	// which a flag may have multiple option values, eg the flag "define"
+	// in "-define a=1 -define b=2" (see EvaluateMulti).
+	MultiValueFlagsMap = collections.OrderedKeysMap[ThirdPartyFlagName, ThirdPartyOptionValues]
+
+	// ThirdPartyFlagOccurrence is a single occurrence of a flag along with
+	// its option value. As per ChangedFlagsMap, a switch has a true/false
+	// option value.
+	ThirdPartyFlagOccurrence struct {
+		// Flag is the bare name of the flag
+		//
+		Flag ThirdPartyFlagName
+
+		// Value is the option value of this occurrence of the flag
+		//
+		Value ThirdPartyOptionValue
+	}
+
+	// OrderedFlags are the occurrences of the flags in the order in which
+	// the user specified them, in which a flag may occur multiple times,
+	// interleaved with other flags, eg the operators of ImageMagick in
+	// "-fill red -draw ... -fill blue -draw ..." (see EvaluateOrdered).
+	OrderedFlags []ThirdPartyFlagOccurrence
+
 	// ThirdPartyChangedFlags (see ChangedFlagsMap).
 	ThirdPartyChangedFlags ChangedFlagsMap
 
@@ -0,0 +1,67 @@
+package clif
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/internal/third/lo"
+)
+
+// Redact returns a copy of the command line suitable for display, eg the
+// result of Evaluate, in which the values of the sensitive flags are replaced
+// by locale.RedactedValue. The sensitive flags are specified by their bare
+// long or short names and are assumed to take a value, so the token that
+// follows a sensitive flag is redacted, even if it begins with a dash. The
+// original command line should still be used for the invocation of the
+// third party command.
+func Redact(commandLine ThirdPartyCommandLine, sensitive ...ThirdPartyFlagName) ThirdPartyCommandLine {
+	return ThirdPartySchema(nil).Redact(commandLine, sensitive...)
+}
+
+// Redact is the form of Redact in which the flags described by the schema
+// are recognised, so that a single dash long flag, eg "-define", is not
+// mistaken for a short flag with an attached value. A sensitive flag that
+// the schema describes as a switch, does not take a value.
+func (schema ThirdPartySchema) Redact(commandLine ThirdPartyCommandLine,
+	sensitive ...ThirdPartyFlagName,
+) ThirdPartyCommandLine {
+	redacted := make(ThirdPartyCommandLine, 0, len(commandLine))
+	pending := false
+
+	// the sensitive flags are the only known flags, so that a single dash
+	// long flag is not mistaken for a short flag with an attached value
+	//
+	knownBy := KnownByCollection{}
+	for _, name := range sensitive {
+		knownBy[name] = name
+	}
+
+	for _, token := range commandLine {
+		if pending {
+			redacted = append(redacted, locale.RedactedValue)
+			pending = false
+
+			continue
+		}
+
+		lead, bare, optionValue, attached := split(token, knownBy, schema)
+
+		switch {
+		case lead == "":
+			redacted = append(redacted, token)
+
+		case attached:
+			redacted = append(redacted, lo.Ternary(slices.Contains(sensitive, bare),
+				strings.TrimSuffix(token, optionValue)+locale.RedactedValue, token,
+			))
+
+		default:
+			takes, found := schema.takesValue(bare, knownBy)
+			redacted = append(redacted, token)
+			pending = slices.Contains(sensitive, bare) && (takes || !found)
+		}
+	}
+
+	return redacted
+}
@@ -0,0 +1,123 @@
+package clif_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+
+	"github.com/snivilised/cobrass/src/clif"
+)
+
+type redactTE struct {
+	baseTE
+	commandLine clif.ThirdPartyCommandLine
+	sensitive   []clif.ThirdPartyFlagName
+}
+
+var _ = Describe("Redact", func() {
+	DescribeTable("ThirdPartyCommandLine",
+		func(entry *redactTE) {
+			actual := clif.Redact(entry.commandLine, entry.sensitive...)
+			Expect(actual).To(HaveExactElements(entry.expected))
+		},
+		func(entry *redactTE) string {
+			return fmt.Sprintf("🧪 ===> given: '%v', should: return '%v'",
+				entry.given, entry.shouldReturn,
+			)
+		},
+
+		Entry(nil, &redactTE{
+			baseTE: baseTE{
+				given:        "sensitive long flag",
+				shouldReturn: "redacted value",
+				expected:     []string{"--token", "******", "--depth", "2"},
+			},
+			commandLine: clif.ThirdPartyCommandLine{"--token", "s3cr3t", "--depth", "2"},
+			sensitive:   []clif.ThirdPartyFlagName{"token", "t"},
+		}),
+
+		Entry(nil, &redactTE{
+			baseTE: baseTE{
+				given:        "sensitive short flag",
+				shouldReturn: "redacted value",
+				expected:     []string{"-t", "******", "file.jpg"},
+			},
+			commandLine: clif.ThirdPartyCommandLine{"-t", "s3cr3t", "file.jpg"},
+			sensitive:   []clif.ThirdPartyFlagName{"token", "t"},
+		}),
+
+		Entry(nil, &redactTE{
+			baseTE: baseTE{
+				given:        "sensitive flag with assigned value",
+				shouldReturn: "redacted value",
+				expected:     []string{"--token=******", "--dry-run"},
+			},
+			commandLine: clif.ThirdPartyCommandLine{"--token=s3cr3t", "--dry-run"},
+			sensitive:   []clif.ThirdPartyFlagName{"token"},
+		}),
+
+		Entry(nil, &redactTE{
+			baseTE: baseTE{
+				given:        "sensitive short flag with attached value",
+				shouldReturn: "redacted value",
+				expected:     []string{"-t******", "-v"},
+			},
+			commandLine: clif.ThirdPartyCommandLine{"-ts3cr3t", "-v"},
+			sensitive:   []clif.ThirdPartyFlagName{"token", "t"},
+		}),
+
+		Entry(nil, &redactTE{
+			baseTE: baseTE{
+				given:        "sensitive single dash long flag",
+				shouldReturn: "redacted value",
+				expected:     []string{"-token", "******", "-verbose"},
+			},
+			commandLine: clif.ThirdPartyCommandLine{"-token", "s3cr3t", "-verbose"},
+			sensitive:   []clif.ThirdPartyFlagName{"token", "t"},
+		}),
+
+		Entry(nil, &redactTE{
+			baseTE: baseTE{
+				given:        "sensitive flag with value beginning with dash",
+				shouldReturn: "redacted value",
+				expected:     []string{"--token", "******", "--x", "1"},
+			},
+			commandLine: clif.ThirdPartyCommandLine{"--token", "-abc123", "--x", "1"},
+			sensitive:   []clif.ThirdPartyFlagName{"token"},
+		}),
+
+		Entry(nil, &redactTE{
+			baseTE: baseTE{
+				given:        "sensitive switch followed by positional",
+				shouldReturn: "positional unchanged",
+				expected:     []string{"--dry-run", "--verbose", "file.jpg"},
+			},
+			commandLine: clif.ThirdPartyCommandLine{"--dry-run", "--verbose", "file.jpg"},
+			sensitive:   []clif.ThirdPartyFlagName{"token"},
+		}),
+	)
+
+	It("🧪 should: recognise single dash long flags described by schema", func() {
+		schema := clif.ThirdPartySchema{
+			"threshold": {Kind: clif.ValueFlagKind},
+		}
+		commandLine := clif.ThirdPartyCommandLine{"-threshold", "50%", "-t", "s3cr3t"}
+
+		Expect(schema.Redact(commandLine, "token", "t")).To(HaveExactElements(
+			"-threshold", "50%", "-t", "******",
+		))
+	})
+
+	It("🧪 should: redact value beginning with dash of flag described by schema", func() {
+		schema := clif.ThirdPartySchema{
+			"token":   {Kind: clif.ValueFlagKind},
+			"dry-run": {Kind: clif.SwitchFlagKind},
+		}
+		commandLine := clif.ThirdPartyCommandLine{"--token", "-abc123", "--dry-run", "--x", "1"}
+
+		Expect(schema.Redact(commandLine, "token", "dry-run")).To(HaveExactElements(
+			"--token", "******", "--dry-run", "--x", "1",
+		))
+	})
+})
@@ -0,0 +1,403 @@
+package clif
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/internal/third/lo"
+)
+
+// FlagKind describes how a third party flag is used on the command line,
+// ie its arity and whether it is repeatable.
+type FlagKind int
+
+const (
+	// UndefinedFlagKind the flag is not described by the schema, so whether
+	// it takes a value is inferred from the shape of the following token.
+	UndefinedFlagKind FlagKind = iota
+
+	// SwitchFlagKind the flag does not take a value, eg "--dry-run"
+	SwitchFlagKind
+
+	// ValueFlagKind the flag takes a value, eg "--offset -5"
+	ValueFlagKind
+
+	// RepeatableFlagKind the flag takes a value and may occur multiple
+	// times, eg "--tag a --tag b"
+	RepeatableFlagKind
+)
+
+// ValueType is the type of the option value of a third party flag.
+type ValueType int
+
+const (
+	// AnyValueType the option value is not checked. This is the default.
+	AnyValueType ValueType = iota
+
+	// IntValueType the option value is a signed integer, eg "-5"
+	IntValueType
+
+	// UintValueType the option value is an unsigned integer, eg "90"
+	UintValueType
+
+	// FloatValueType the option value is a floating point number, eg "0.05"
+	FloatValueType
+
+	// BoolValueType the option value is a boolean, eg "true"
+	BoolValueType
+
+	// DurationValueType the option value is a duration, eg "1m30s"
+	DurationValueType
+)
+
+func (vt ValueType) String() string {
+	switch vt {
+	case IntValueType:
+		return "int"
+	case UintValueType:
+		return "uint"
+	case FloatValueType:
+		return "float"
+	case BoolValueType:
+		return "bool"
+	case DurationValueType:
+		return "duration"
+	case AnyValueType:
+	}
+
+	return "any"
+}
+
+// parses determines whether the value can be parsed as the type.
+func (vt ValueType) parses(value string) bool {
+	var err error
+
+	switch vt {
+	case IntValueType:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case UintValueType:
+		_, err = strconv.ParseUint(value, 10, 64)
+	case FloatValueType:
+		_, err = strconv.ParseFloat(value, 64)
+	case BoolValueType:
+		_, err = strconv.ParseBool(value)
+	case DurationValueType:
+		_, err = time.ParseDuration(value)
+	case AnyValueType:
+	}
+
+	return err == nil
+}
+
+// MergePolicy determines how the option values of a flag that is specified,
+// are combined with those of its occurrences in the secondary command line
+// (see EvaluateMulti).
+type MergePolicy int
+
+const (
+	// ReplaceMergePolicy the specified values replace those in secondary.
+	// This is the default.
+	ReplaceMergePolicy MergePolicy = iota
+
+	// AppendMergePolicy the specified values are appended to those in
+	// secondary.
+	AppendMergePolicy
+
+	// UnionMergePolicy the specified values are appended to those in
+	// secondary, less any duplicates.
+	UnionMergePolicy
+)
+
+// merge combines the secondary values with the present values.
+func (policy MergePolicy) merge(secondary, present ThirdPartyOptionValues) ThirdPartyOptionValues {
+	return mergeValues(policy, secondary, present, func(value ThirdPartyOptionValue) string {
+		return value
+	})
+}
+
+// mergeLayer combines the values of a flag in the preceding layers with
+// those of a layer (see EvaluateLayers).
+func (policy MergePolicy) mergeLayer(lower, upper []layerValue) []layerValue {
+	return mergeValues(policy, lower, upper, func(v layerValue) string {
+		return lo.Ternary(v.negated, "!", "") + v.value
+	})
+}
+
+// mergeValues combines the lower values with the upper values according
+// to the policy, where values are compared by their key.
+func mergeValues[T any](policy MergePolicy, lower, upper []T, key func(T) string) []T {
+	switch policy {
+	case AppendMergePolicy:
+		return append(slices.Clone(lower), upper...)
+
+	case UnionMergePolicy:
+		merged := []T{}
+		keys := []string{}
+
+		for _, value := range append(slices.Clone(lower), upper...) {
+			if k := key(value); !slices.Contains(keys, k) {
+				keys = append(keys, k)
+				merged = append(merged, value)
+			}
+		}
+
+		return merged
+
+	case ReplaceMergePolicy:
+	}
+
+	return upper
+}
+
+// ThirdPartyFlagSpec describes a flag of a third party command.
+type ThirdPartyFlagSpec struct {
+	// Kind denotes whether the flag takes a value and whether it may
+	// be repeated.
+	//
+	Kind FlagKind
+
+	// Type is the type of the option value, ignored for a switch.
+	//
+	Type ValueType
+
+	// Acceptables when specified, is the set of values the option value
+	// is restricted to, eg []string{"plane", "line", "none"}.
+	//
+	Acceptables []string
+
+	// Merge is the merge policy of a flag that may be specified multiple
+	// times, defaults to ReplaceMergePolicy.
+	//
+	Merge MergePolicy
+}
+
+// ThirdPartySchema describes the flags of a third party command, keyed by
+// their bare long name. It enables Evaluate to pair a flag with its value
+// based on knowledge of the third party command, rather than the shape of
+// the tokens, so that values beginning with a dash, eg negative numbers,
+// are not mistaken for flags. It also enables the flags to be validated
+// before the third party command is launched (see Validate).
+type ThirdPartySchema map[ThirdPartyFlagName]ThirdPartyFlagSpec
+
+// lookup returns the bare long name and the spec of the flag, which may be
+// specified by its long or short name, resolved using the bilateral knownBy
+// collection.
+func (schema ThirdPartySchema) lookup(bare string,
+	knownBy KnownByCollection,
+) (name ThirdPartyFlagName, spec ThirdPartyFlagSpec, found bool) {
+	if spec, found = schema[bare]; found {
+		return bare, spec, true
+	}
+
+	if aka, known := knownBy[bare]; known {
+		if spec, found = schema[aka]; found {
+			return aka, spec, true
+		}
+	}
+
+	return bare, ThirdPartyFlagSpec{}, false
+}
+
+// kindOf returns the kind of the flag, which may be specified by its long
+// or short name, resolved using the bilateral knownBy collection.
+func (schema ThirdPartySchema) kindOf(bare string, knownBy KnownByCollection) FlagKind {
+	_, spec, _ := schema.lookup(bare, knownBy)
+
+	return spec.Kind
+}
+
+// takesValue determines whether the flag takes a value, found is false if
+// the flag is not described by the schema.
+func (schema ThirdPartySchema) takesValue(bare string, knownBy KnownByCollection) (takes, found bool) {
+	switch schema.kindOf(bare, knownBy) {
+	case SwitchFlagKind:
+		return false, true
+	case ValueFlagKind, RepeatableFlagKind:
+		return true, true
+	case UndefinedFlagKind:
+	}
+
+	return false, false
+}
+
+// multi converts the present flags into their multi valued form, in which
+// the value of a repeatable flag is split into its elements.
+func (schema ThirdPartySchema) multi(presentFlags ChangedFlagsMap,
+	knownBy KnownByCollection,
+) MultiValueFlagsMap {
+	multi := make(MultiValueFlagsMap, len(presentFlags))
+	bilateralKnownBy := composeBilateral(knownBy)
+
+	for flag, option := range presentFlags {
+		multi[flag] = lo.Ternary(schema.kindOf(flag, bilateralKnownBy) == RepeatableFlagKind,
+			repeatedValues(option), ThirdPartyOptionValues{option},
+		)
+	}
+
+	return multi
+}
+
+// Validate checks the present flags and the secondary command line, which
+// is typically provided by config, against the schema, so that invalid
+// flags are reported before the third party command is launched, rather
+// than by the third party command itself. All the errors found are returned
+// as a locale.AggregateValidationError, each of which identifies the
+// offending flag by its bare long name. A flag is invalid if it is not
+// described by the schema, is a switch with a value, is missing a value,
+// has a value not of its declared type or not one of its acceptables, or
+// is not repeatable but occurs more than once in the secondary command
+// line. Positional arguments in the secondary command line, including
+// those following "--", are ignored.
+func (schema ThirdPartySchema) Validate(presentFlags ChangedFlagsMap,
+	knownBy KnownByCollection,
+	secondaryCL ThirdPartyCommandLine,
+) error {
+	return schema.ValidateMulti(schema.multi(presentFlags, knownBy), knownBy, secondaryCL)
+}
+
+// ValidateMulti is the multi valued form of Validate, in which a flag that
+// is not repeatable is also invalid if it has multiple values.
+func (schema ThirdPartySchema) ValidateMulti(presentFlags MultiValueFlagsMap,
+	knownBy KnownByCollection,
+	secondaryCL ThirdPartyCommandLine,
+) error {
+	bilateralKnownBy := composeBilateral(knownBy)
+	errs := schema.validatePresent(presentFlags, bilateralKnownBy)
+	errs = append(errs, schema.validateCommandLine(secondaryCL, bilateralKnownBy)...)
+
+	return locale.NewAggregateValidationError(errs)
+}
+
+func (schema ThirdPartySchema) validatePresent(presentFlags MultiValueFlagsMap,
+	knownBy KnownByCollection,
+) []error {
+	errs := []error{}
+
+	for _, flag := range presentFlags.Keys() {
+		name, spec, found := schema.lookup(flag, knownBy)
+
+		if !found {
+			errs = append(errs, locale.NewUnknownThirdPartyFlagValidationError(name))
+
+			continue
+		}
+
+		values := presentFlags[flag]
+
+		if len(values) > 1 && spec.Kind != RepeatableFlagKind {
+			errs = append(errs, locale.NewRepeatedThirdPartyFlagValidationError(name))
+		}
+
+		for _, value := range values {
+			if spec.Kind == SwitchFlagKind {
+				if !slices.Contains(booleans, value) {
+					errs = append(errs, locale.NewUnexpectedThirdPartyValueValidationError(name, value))
+				}
+
+				continue
+			}
+
+			errs = appendIfError(errs, spec.check(name, value))
+		}
+	}
+
+	return errs
+}
+
+func (schema ThirdPartySchema) validateCommandLine(secondaryCL ThirdPartyCommandLine,
+	knownBy KnownByCollection,
+) []error {
+	errs := []error{}
+	occurrences := map[ThirdPartyFlagName]int{}
+
+	for t := 0; t < len(secondaryCL); t++ {
+		token := secondaryCL[t]
+
+		// the remaining tokens are positional
+		//
+		if token == "--" {
+			break
+		}
+
+		lead, bare, optionValue, attached := split(token, knownBy, schema)
+
+		if lead == "" {
+			continue
+		}
+
+		name, spec, found := schema.lookup(bare, knownBy)
+
+		if !found {
+			errs = append(errs, locale.NewUnknownThirdPartyFlagValidationError(name))
+
+			continue
+		}
+
+		if occurrences[name]++; occurrences[name] == 2 && spec.Kind != RepeatableFlagKind {
+			errs = append(errs, locale.NewRepeatedThirdPartyFlagValidationError(name))
+		}
+
+		if spec.Kind == SwitchFlagKind {
+			if attached {
+				errs = append(errs, locale.NewUnexpectedThirdPartyValueValidationError(name, optionValue))
+			}
+
+			continue
+		}
+
+		if !attached {
+			if t+1 >= len(secondaryCL) {
+				errs = append(errs, locale.NewMissingThirdPartyValueValidationError(name))
+
+				continue
+			}
+
+			t++
+			optionValue = secondaryCL[t]
+		}
+
+		errs = appendIfError(errs, spec.check(name, optionValue))
+	}
+
+	return errs
+}
+
+// check validates the option value of the flag against the spec.
+func (spec ThirdPartyFlagSpec) check(name ThirdPartyFlagName, value string) error {
+	if value == "" {
+		return locale.NewMissingThirdPartyValueValidationError(name)
+	}
+
+	if !spec.Type.parses(value) {
+		return locale.NewInvalidThirdPartyValueValidationError(name, value, spec.Type.String())
+	}
+
+	if len(spec.Acceptables) > 0 && !slices.Contains(spec.Acceptables, value) {
+		return locale.NewNotAcceptableThirdPartyValueValidationError(name, value, spec.Acceptables)
+	}
+
+	return nil
+}
+
+func appendIfError(errs []error, err error) []error {
+	if err != nil {
+		return append(errs, err)
+	}
+
+	return errs
+}
+
+// repeatedValues splits the option value of a repeatable flag, which may be
+// in the form of a string slice flag value, eg "[a,b]", into its elements.
+func repeatedValues(option string) []string {
+	trimmed := strings.TrimSuffix(strings.TrimPrefix(option, "["), "]")
+
+	if trimmed == "" {
+		return []string{}
+	}
+
+	return strings.Split(trimmed, ",")
+}
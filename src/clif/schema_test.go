@@ -0,0 +1,163 @@
+package clif_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo ok
+	. "github.com/onsi/gomega"    //nolint:revive // gomega ok
+
+	"github.com/snivilised/cobrass/src/assistant/locale"
+	"github.com/snivilised/cobrass/src/clif"
+	"github.com/snivilised/cobrass/src/internal/lab"
+	"github.com/snivilised/li18ngo"
+)
+
+type validateTE struct {
+	given     string
+	specified clif.ChangedFlagsMap
+	secondary clif.ThirdPartyCommandLine
+	expected  []string
+}
+
+var _ = Describe("ThirdPartySchema", Ordered, func() {
+	var (
+		schema  clif.ThirdPartySchema
+		knownBy clif.KnownByCollection
+	)
+
+	BeforeAll(func() {
+		if err := li18ngo.Use(func(o *li18ngo.UseOptions) {
+			o.From = li18ngo.LoadFrom{
+				Path: lab.Path(lab.Repo("../.."), "Test/data/l10n"),
+				Sources: li18ngo.TranslationFiles{
+					locale.CobrassSourceID: li18ngo.TranslationSource{Name: "test"},
+				},
+			}
+		}); err != nil {
+			Fail(err.Error())
+		}
+
+		schema = clif.ThirdPartySchema{
+			"strip":   {Kind: clif.SwitchFlagKind},
+			"quality": {Kind: clif.ValueFlagKind, Type: clif.UintValueType},
+			"offset":  {Kind: clif.ValueFlagKind, Type: clif.IntValueType},
+			"timeout": {Kind: clif.ValueFlagKind, Type: clif.DurationValueType},
+			"interlace": {
+				Kind:        clif.ValueFlagKind,
+				Acceptables: []string{"plane", "line", "none"},
+			},
+			"define":  {Kind: clif.RepeatableFlagKind},
+			"sharpen": {Kind: clif.ValueFlagKind},
+		}
+		knownBy = clif.KnownByCollection{
+			"strip":     "s",
+			"quality":   "q",
+			"interlace": "i",
+		}
+	})
+
+	DescribeTable("Validate",
+		func(entry *validateTE) {
+			err := schema.Validate(entry.specified, knownBy, entry.secondary)
+
+			if len(entry.expected) == 0 {
+				Expect(err).To(Succeed())
+
+				return
+			}
+
+			aggregate, ok := err.(*locale.AggregateValidationError)
+			Expect(ok).To(BeTrue())
+			Expect(aggregate.Errors).To(HaveLen(len(entry.expected)))
+
+			for i, expected := range entry.expected {
+				Expect(aggregate.Errors[i]).To(MatchError(ContainSubstring(expected)))
+			}
+		},
+		func(entry *validateTE) string {
+			return fmt.Sprintf("🧪 ===> given: '%v', should: return '%v' errors",
+				entry.given, len(entry.expected),
+			)
+		},
+
+		Entry(nil, &validateTE{
+			given: "valid flags",
+			specified: clif.ChangedFlagsMap{
+				"quality": "90",
+				"strip":   "true",
+				"define":  "[a=1,b=2]",
+			},
+			secondary: clif.ThirdPartyCommandLine{
+				"--offset", "-5", "-i", "plane", "--timeout=1m30s",
+				"--define", "c=3", "--define", "d=4", "file.jpg", "--", "--bogus",
+			},
+		}),
+		Entry(nil, &validateTE{
+			given: "valid single dash long flags",
+			secondary: clif.ThirdPartyCommandLine{
+				"-sharpen", "0x1", "-define", "a=1", "-quality", "85", "-strip",
+			},
+		}),
+		Entry(nil, &validateTE{
+			given:     "unknown flags",
+			specified: clif.ChangedFlagsMap{"bogus": "1"},
+			secondary: clif.ThirdPartyCommandLine{"-x"},
+			expected:  []string{"(bogus): third party flag is not recognised", "(x)"},
+		}),
+		Entry(nil, &validateTE{
+			given:     "switch with value",
+			specified: clif.ChangedFlagsMap{"strip": "all"},
+			secondary: clif.ThirdPartyCommandLine{"--strip=all"},
+			expected:  []string{"(strip): third party switch does not take a value", "(strip)"},
+		}),
+		Entry(nil, &validateTE{
+			given:     "missing value",
+			specified: clif.ChangedFlagsMap{"offset": ""},
+			secondary: clif.ThirdPartyCommandLine{"-q"},
+			expected:  []string{"(offset): third party flag requires a value", "(quality)"},
+		}),
+		Entry(nil, &validateTE{
+			given:     "value of wrong type",
+			specified: clif.ChangedFlagsMap{"quality": "-1"},
+			secondary: clif.ThirdPartyCommandLine{"--timeout", "soon"},
+			expected: []string{
+				"(quality): third party option value '-1' is not a valid uint",
+				"(timeout): third party option value 'soon' is not a valid duration",
+			},
+		}),
+		Entry(nil, &validateTE{
+			given:     "value not acceptable",
+			secondary: clif.ThirdPartyCommandLine{"-i", "zigzag"},
+			expected:  []string{"(interlace): third party option value 'zigzag' is not one of"},
+		}),
+		Entry(nil, &validateTE{
+			given:     "repeated flag not repeatable",
+			secondary: clif.ThirdPartyCommandLine{"--quality", "80", "-q", "90", "--define", "a", "--define", "b"},
+			expected:  []string{"(quality): third party flag may only be specified once"},
+		}),
+	)
+
+	It("🧪 should: identify the offending flag", func() {
+		err := schema.Validate(nil, knownBy, clif.ThirdPartyCommandLine{"-i", "zigzag"})
+
+		aggregate, _ := err.(*locale.AggregateValidationError)
+		Expect(aggregate).NotTo(BeNil())
+
+		_, ok := aggregate.Errors[0].(locale.NotAcceptableThirdPartyValueBehaviourQuery)
+		Expect(ok).To(BeTrue())
+
+		structured, ok := aggregate.Errors[0].(locale.StructuredValidationError)
+		Expect(ok).To(BeTrue())
+		Expect(structured.Failure().Flag).To(Equal("interlace"))
+	})
+
+	It("🧪 should: reject multiple values for flag that is not repeatable", func() {
+		err := schema.ValidateMulti(clif.MultiValueFlagsMap{
+			"quality": clif.ThirdPartyOptionValues{"80", "90"},
+			"define":  clif.ThirdPartyOptionValues{"a=1", "b=2"},
+		}, knownBy, nil)
+
+		Expect(err).To(MatchError(ContainSubstring("(quality): third party flag may only be specified once")))
+		Expect(err.(*locale.AggregateValidationError).Errors).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/snivilised/cobrass/src/assistant"
+	nef "github.com/snivilised/nefilim"
+)
+
+const (
+	appName              = "cobrass-compat"
+	breakingExitCode     = 1
+	invalidManifestsCode = 2
+	compatibleMessage    = "✅ ---> no breaking changes detected"
+)
+
+var (
+	previousFlag = flag.String("previous", "", "path to the manifest of the previous release")
+	currentFlag  = flag.String("current", "", "path to the manifest of the current release")
+)
+
+func main() {
+	flag.Usage = Usage
+	flag.Parse()
+
+	if *previousFlag == "" || *currentFlag == "" {
+		fail("both previous and current manifests must be specified")
+	}
+
+	nativeFS := nef.NewUniversalABS()
+	previous := load(nativeFS, *previousFlag)
+	current := load(nativeFS, *currentFlag)
+
+	changes := assistant.CheckCompatibility(previous, current)
+
+	if len(changes) == 0 {
+		fmt.Println(compatibleMessage)
+		os.Exit(0)
+	}
+
+	for _, change := range changes {
+		fmt.Printf("💥 ---> %v\n", change)
+	}
+
+	os.Exit(breakingExitCode)
+}
+
+func Usage() {
+	fmt.Fprintf(os.Stderr, "Use of %v:\n", appName)
+	fmt.Fprintf(os.Stderr, "compares manifests created by CobraContainer.Manifest ...\n")
+	fmt.Fprintf(os.Stderr, "\t%v [Flags]\n", appName)
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func fail(reason string) {
+	fmt.Fprintf(os.Stderr, "🔥 Failed: '%v'\n", reason)
+	flag.Usage()
+	os.Exit(invalidManifestsCode)
+}
+
+func load(fS nef.ReaderFS, path string) *assistant.Manifest {
+	data, err := fS.ReadFile(path)
+
+	if err != nil {
+		fail(err.Error())
+	}
+
+	manifest, err := assistant.UnmarshalManifest(data)
+
+	if err != nil {
+		fail(fmt.Sprintf("invalid manifest: '%v' (%v)", path, err))
+	}
+
+	return manifest
+}
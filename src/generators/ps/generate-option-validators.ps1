@@ -747,6 +747,7 @@ $types = @{
     ArgsPlaceholder    = "[%v]..[%v]"
     ErrorArgs          = "low, high"
     ErrorTempl         = "New{{Not}}WithinOptValidationError"
+    ConstraintTempl    = "rangeConstraint(Constraint{{Not}}Within, low, high)"
     Comment            = "option value must be within the range"
     #
     Negate             = $true
@@ -766,6 +767,7 @@ $types = @{
     ArgsPlaceholder      = "[%v]"
     ErrorArgs            = "collection"
     ErrorTempl           = "New{{Not}}ContainsOptValidationError"
+    ConstraintTempl      = "memberConstraint(Constraint{{Not}}Contains, collection)"
     Comment              = "option value must be a member of collection"
     #
     Negate               = $true
@@ -785,6 +787,7 @@ $types = @{
     ArgsPlaceholder      = "[%v]"
     ErrorArgs            = "pattern"
     ErrorTempl           = "New{{Not}}MatchOptValidationError"
+    ConstraintTempl      = "matchConstraint(Constraint{{Not}}Match, pattern)"
     Comment              = "option value must match regex pattern"
     #
     Negate               = $true
@@ -803,6 +806,7 @@ $types = @{
     ArgsPlaceholder = "[%v]"
     ErrorArgs       = "threshold"
     ErrorTempl      = "NewGreaterThanOptValidationError"
+    ConstraintTempl = "thresholdConstraint(ConstraintGreaterThan, threshold)"
     Comment         = "option value must be greater than threshold"
     #
     # Relatable is the op equivalent of spec.Comparable. Operations that are relatable
@@ -821,6 +825,7 @@ $types = @{
     ArgsPlaceholder = "[%v]"
     ErrorArgs       = "threshold"
     ErrorTempl      = "NewAtLeastOptValidationError"
+    ConstraintTempl = "thresholdConstraint(ConstraintAtLeast, threshold)"
     Comment         = "option value must be greater than or equal to threshold"
     #
     Relatable       = $true
@@ -836,6 +841,7 @@ $types = @{
     ArgsPlaceholder = "[%v]"
     ErrorArgs       = "threshold"
     ErrorTempl      = "NewLessThanOptValidationError"
+    ConstraintTempl = "thresholdConstraint(ConstraintLessThan, threshold)"
     Comment         = "option value must be less than threshold"
     #
     Relatable       = $true
@@ -851,6 +857,7 @@ $types = @{
     ArgsPlaceholder = "[%v]"
     ErrorArgs       = "threshold"
     ErrorTempl      = "NewAtMostOptValidationError"
+    ConstraintTempl = "thresholdConstraint(ConstraintAtMost, threshold)"
     Comment         = "option value must be less than or equal to threshold"
     #
     Relatable       = $true
@@ -993,6 +1000,8 @@ func (params *ParamSet[N]) Bind$($spec.TypeName)(info *FlagInfo, to *$($spec.GoT
     flagSet.$($actualTypeName)VarP(to, info.FlagName(), info.Short, info.Default.($($spec.GoType)), info.Usage)
   }
 
+  markSensitive(flagSet, info)
+
   return params
 }
 
@@ -1040,6 +1049,8 @@ func (params *ParamSet[N]) Bind$($sliceTypeName)(info *FlagInfo, to *$($sliceTyp
     flagSet.$($sliceTypeName)VarP(to, info.FlagName(), info.Short, info.Default.($($defaultSlice)), info.Usage)
   }
 
+  markSensitive(flagSet, info)
+
   return params
 }
 
@@ -1347,7 +1358,8 @@ function Build-BinderHelpers {
         }
 
         $errorFn = $op.ErrorTempl.Replace("{{Not}}", [string]::Empty)
-        $errorF = "i18n.$($errorFn)(info.FlagName(), value, $($op.ErrorArgs))"
+        $validatorF = "$($errorFn.Replace('New', [string]::Empty).Replace('OptValidationError', 'Validator'))($($op.ErrorArgs))"
+        $constraintF = $op.ConstraintTempl.Replace("{{Not}}", [string]::Empty)
 
         # generate BuildValidatedXXXXOp/BuildValidatedOpXXXX
         #
@@ -1359,18 +1371,10 @@ func (params *ParamSet[N]) BindValidated$($methodSubStmt)(info *FlagInfo, to *$(
   params.Bind$($spec.TypeName)(info, to)
 
   wrapper := GenericOptionValidatorWrapper[$($spec.GoType)]{
-    Fn: func(value $($spec.GoType), flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-      if $($op.Condition) {
-        return nil
-      }
-
-      return $($errorF)
-    },
-    Value: to,
-    Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+    Fn: whenChanged($($validatorF)),
+    Value:      to,
+    Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+    Constraint: $($constraintF),
   }
   params.validators.Add(info.FlagName(), wrapper)
 
@@ -1392,7 +1396,8 @@ func (params *ParamSet[N]) BindValidated$($methodSubStmt)(info *FlagInfo, to *$(
           $negatedCondition = $("!($($op.Condition))")
 
           $errorFn = $op.ErrorTempl.Replace("{{Not}}", "Not")
-          $errorF = "i18n.$($errorFn)(info.FlagName(), value, $($op.ErrorArgs))"
+          $validatorF = "$($errorFn.Replace('New', [string]::Empty).Replace('OptValidationError', 'Validator'))($($op.ErrorArgs))"
+          $constraintF = $op.ConstraintTempl.Replace("{{Not}}", "Not")
   
           # generate NOT method
           #
@@ -1404,18 +1409,10 @@ func (params *ParamSet[N]) BindValidated$($notMethodSubStmt)(info *FlagInfo, to
   params.Bind$($spec.TypeName)(info, to)
 
   wrapper := GenericOptionValidatorWrapper[$($spec.GoType)]{
-    Fn: func(value $($spec.GoType), flag *pflag.Flag) error {
-			if !flag.Changed {
-				return nil
-			}
-      if $($negatedCondition) {
-        return nil
-      }
-
-      return $($errorF)
-    },
-    Value: to,
-    Flag:  params.ResolveFlagSet(info).Lookup(info.Name),
+    Fn: whenChanged($($validatorF)),
+    Value:      to,
+    Flag:       params.ResolveFlagSet(info).Lookup(info.Name),
+    Constraint: $($constraintF),
   }
   params.validators.Add(info.FlagName(), wrapper)
 
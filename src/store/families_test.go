@@ -416,6 +416,28 @@ var _ = Describe("Families", Ordered, func() {
 		})
 	})
 
+	When("regex filter provided", func() {
+		It("should: 🧪 store compiled regex", func() {
+			ps := assistant.NewParamSet[store.PolyFilterParameterSet](rootCommand)
+			ps.Native.BindAll(ps)
+
+			_, err := lab.ExecuteCommand(rootCommand, "--files-rx", "^foo", "--folders-gb", "bar*")
+			Expect(err).To(Succeed())
+			Expect(ps.Native.FilesRexEx.MatchString("foo.txt")).To(BeTrue())
+			Expect(ps.Native.FoldersRexEx).To(BeNil())
+		})
+	})
+
+	When("invalid regex filter provided", func() {
+		It("should: 🧪 return error", func() {
+			ps := assistant.NewParamSet[store.FilesFilterParameterSet](rootCommand)
+			ps.Native.BindAll(ps)
+
+			_, err := lab.ExecuteCommand(rootCommand, "--files-rx", "(")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	DescribeTable("cascade family",
 		func(entry *familyTE) {
 			ps := assistant.NewParamSet[store.CascadeParameterSet](rootCommand)
@@ -561,3 +583,205 @@ var _ = Describe("Families", Ordered, func() {
 		),
 	)
 })
+
+type composedParameterSet struct {
+	store.PreviewParameterSet
+	store.WorkerPoolParameterSet
+	Profile *store.ProfileParameterSet
+	Pattern string
+}
+
+var _ = Describe("BindFamilies", Ordered, func() {
+	var (
+		rootCommand *cobra.Command
+		paramSet    *assistant.ParamSet[composedParameterSet]
+	)
+
+	BeforeAll(func() {
+		if err := li18ngo.Use(func(o *li18ngo.UseOptions) {
+			o.From = li18ngo.LoadFrom{
+				Path: lab.Path(lab.Repo("../.."), "Test/data/l10n"),
+				Sources: li18ngo.TranslationFiles{
+					locale.CobrassSourceID: li18ngo.TranslationSource{Name: "test"},
+				},
+			}
+		}); err != nil {
+			Fail(err.Error())
+		}
+	})
+
+	BeforeEach(func() {
+		rootCommand = &cobra.Command{
+			Use: "scorch",
+			RunE: func(_ *cobra.Command, _ []string) error {
+				return paramSet.Validate()
+			},
+		}
+		paramSet = assistant.NewParamSet[composedParameterSet](rootCommand)
+		store.BindFamilies(paramSet)
+	})
+
+	Context("given: embedded families", func() {
+		It("🧪 should: bind all families to outer param set", func() {
+			_, err := lab.ExecuteCommand(rootCommand,
+				"--dry-run", "--now", "4", "--profile", "blur",
+			)
+
+			Expect(err).To(Succeed())
+			Expect(paramSet.Native.DryRun).To(BeTrue())
+			Expect(paramSet.Native.NoWorkers).To(Equal(4))
+			Expect(paramSet.Native.Profile).NotTo(BeNil())
+			Expect(paramSet.Native.Profile.Profile).To(Equal("blur"))
+		})
+	})
+
+	Context("given: family flag fails validation", func() {
+		It("🧪 should: fail outer param set validation", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--now", "999")
+
+			Expect(err).To(HaveOccurred())
+			_, ok := err.(locale.WithinOptValidationBehaviourQuery)
+			Expect(ok).To(BeTrue())
+		})
+	})
+
+	Context("given: mutually exclusive family flags", func() {
+		It("🧪 should: fail", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--now", "4", "--cpu")
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
+var _ = Describe("ShortFlagPolicy", Ordered, func() {
+	var (
+		rootCommand *cobra.Command
+		paramSet    *assistant.ParamSet[composedParameterSet]
+	)
+
+	BeforeAll(func() {
+		if err := li18ngo.Use(func(o *li18ngo.UseOptions) {
+			o.From = li18ngo.LoadFrom{
+				Path: lab.Path(lab.Repo("../.."), "Test/data/l10n"),
+				Sources: li18ngo.TranslationFiles{
+					locale.CobrassSourceID: li18ngo.TranslationSource{Name: "test"},
+				},
+			}
+		}); err != nil {
+			Fail(err.Error())
+		}
+	})
+
+	BeforeEach(func() {
+		rootCommand = &cobra.Command{
+			Use: "scorch",
+			RunE: func(_ *cobra.Command, _ []string) error {
+				return nil
+			},
+		}
+		paramSet = assistant.NewParamSet[composedParameterSet](rootCommand)
+	})
+
+	Context("given: short flag overrides", func() {
+		It("🧪 should: bind family flags with overridden short names", func() {
+			rootCommand.Flags().BoolP("dump", "D", false, "dump")
+			paramSet.WithShortFlagPolicy(assistant.ShortFlagOverrides{
+				"dry-run": "d",
+			})
+			store.BindFamilies(paramSet)
+
+			_, err := lab.ExecuteCommand(rootCommand, "-d")
+
+			Expect(err).To(Succeed())
+			Expect(paramSet.Native.DryRun).To(BeTrue())
+		})
+	})
+
+	Context("given: drop colliding short flags policy", func() {
+		It("🧪 should: bind colliding family flags without short names", func() {
+			rootCommand.Flags().BoolP("dump", "D", false, "dump")
+			paramSet.WithShortFlagPolicy(assistant.DropCollidingShortFlags)
+			store.BindFamilies(paramSet)
+
+			Expect(rootCommand.Flags().Lookup("dry-run").Shorthand).To(BeEmpty())
+			_, err := lab.ExecuteCommand(rootCommand, "--dry-run", "-D")
+
+			Expect(err).To(Succeed())
+			Expect(paramSet.Native.DryRun).To(BeTrue())
+		})
+	})
+})
+
+type requiredComposedParameterSet struct {
+	store.CliInteractionParameterSet
+	Source string
+}
+
+var _ = Describe("CliInteractionParameterSet.RequiredPrompter", Ordered, func() {
+	var (
+		rootCommand *cobra.Command
+		paramSet    *assistant.ParamSet[requiredComposedParameterSet]
+		prompted    int
+	)
+
+	BeforeAll(func() {
+		if err := li18ngo.Use(func(o *li18ngo.UseOptions) {
+			o.From = li18ngo.LoadFrom{
+				Path: lab.Path(lab.Repo("../.."), "Test/data/l10n"),
+				Sources: li18ngo.TranslationFiles{
+					locale.CobrassSourceID: li18ngo.TranslationSource{Name: "test"},
+				},
+			}
+		}); err != nil {
+			Fail(err.Error())
+		}
+	})
+
+	BeforeEach(func() {
+		prompted = 0
+		rootCommand = &cobra.Command{
+			Use:          "scorch",
+			SilenceUsage: true,
+			RunE: func(_ *cobra.Command, _ []string) error {
+				return paramSet.Validate()
+			},
+		}
+		paramSet = assistant.NewParamSet[requiredComposedParameterSet](rootCommand)
+		store.BindFamilies(paramSet)
+
+		info := assistant.NewFlagInfo("source file to read", "", "")
+		info.Required = true
+		paramSet.BindString(info, &paramSet.Native.Source)
+		paramSet.WithRequiredPrompter(
+			paramSet.Native.CliInteractionParameterSet.RequiredPrompter(
+				func(_ *assistant.PromptRequest) (string, error) {
+					prompted++
+
+					return "foo.txt", nil
+				},
+			),
+		)
+	})
+
+	Context("given: tui", func() {
+		It("🧪 should: prompt for missing required flag", func() {
+			_, err := lab.ExecuteCommand(rootCommand, "--tui")
+
+			Expect(err).To(Succeed())
+			Expect(prompted).To(Equal(1))
+			Expect(paramSet.Native.Source).To(Equal("foo.txt"))
+		})
+	})
+
+	Context("given: not tui", func() {
+		It("🧪 should: not prompt and fail", func() {
+			_, err := lab.ExecuteCommand(rootCommand)
+
+			Expect(err).To(HaveOccurred())
+			Expect(prompted).To(Equal(0))
+			_, ok := err.(locale.MissingRequiredOptionBehaviourQuery)
+			Expect(ok).To(BeTrue())
+		})
+	})
+})
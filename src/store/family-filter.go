@@ -39,7 +39,7 @@ const (
 type FilesFilterParameterSet struct {
 	Files      string
 	FilesGlob  string
-	FilesRexEx string
+	FilesRexEx *regexp.Regexp
 }
 
 func (f *FilesFilterParameterSet) BindAll(
@@ -70,17 +70,13 @@ func (f *FilesFilterParameterSet) BindAll(
 
 	// --files-rx(X)
 	//
-	parent.BindValidatedString(
+	parent.BindRegexp(
 		resolveNewFlagInfo(
 			li18ngo.Text(locale.FilesRegExParamUsageTemplData{}),
 			defaultFilterValue,
 			flagSet...,
 		),
 		&parent.Native.FilesRexEx,
-		func(value string, _ *pflag.Flag) error {
-			_, err := regexp.Compile(value)
-			return err
-		},
 	)
 
 	parent.Command.MarkFlagsMutuallyExclusive("files", "files-gb", "files-rx")
@@ -92,7 +88,7 @@ func (f *FilesFilterParameterSet) BindAll(
 // so the regular glob will suffice.
 type FoldersFilterParameterSet struct {
 	FoldersGlob  string
-	FoldersRexEx string
+	FoldersRexEx *regexp.Regexp
 }
 
 func (f *FoldersFilterParameterSet) BindAll(
@@ -112,17 +108,13 @@ func (f *FoldersFilterParameterSet) BindAll(
 
 	// --folders-rx(y)
 	//
-	parent.BindValidatedString(
+	parent.BindRegexp(
 		resolveNewFlagInfo(
 			li18ngo.Text(locale.FoldersRexExParamUsageTemplData{}),
 			defaultFilterValue,
 			flagSet...,
 		),
 		&parent.Native.FoldersRexEx,
-		func(value string, _ *pflag.Flag) error {
-			_, err := regexp.Compile(value)
-			return err
-		},
 	)
 
 	parent.Command.MarkFlagsMutuallyExclusive("folders-gb", "folders-rx")
@@ -134,9 +126,9 @@ func (f *FoldersFilterParameterSet) BindAll(
 // folders, either a regular glob or regex is supported.
 type PolyFilterParameterSet struct {
 	Files        string
-	FilesRexEx   string
+	FilesRexEx   *regexp.Regexp
 	FoldersGlob  string
-	FoldersRexEx string
+	FoldersRexEx *regexp.Regexp
 }
 
 func (f *PolyFilterParameterSet) BindAll(
@@ -156,17 +148,13 @@ func (f *PolyFilterParameterSet) BindAll(
 
 	// --files-rx(X)
 	//
-	parent.BindValidatedString(
+	parent.BindRegexp(
 		resolveNewFlagInfo(
 			li18ngo.Text(locale.FilesRegExParamUsageTemplData{}),
 			defaultFilterValue,
 			flagSet...,
 		),
 		&parent.Native.FilesRexEx,
-		func(value string, _ *pflag.Flag) error {
-			_, err := regexp.Compile(value)
-			return err
-		},
 	)
 
 	// --folders-gb(Z)
@@ -182,17 +170,13 @@ func (f *PolyFilterParameterSet) BindAll(
 
 	// --folders-rx(y)
 	//
-	parent.BindValidatedString(
+	parent.BindRegexp(
 		resolveNewFlagInfo(
 			li18ngo.Text(locale.FoldersRexExParamUsageTemplData{}),
 			defaultFilterValue,
 			flagSet...,
 		),
 		&parent.Native.FoldersRexEx,
-		func(value string, _ *pflag.Flag) error {
-			_, err := regexp.Compile(value)
-			return err
-		},
 	)
 
 	parent.Command.MarkFlagsMutuallyExclusive("files", "files-rx")
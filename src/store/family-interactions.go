@@ -31,6 +31,18 @@ func (f *TextualInteractionParameterSet) BindAll(
 	)
 }
 
+// InteractivePrompter creates an interactive prompter (see
+// assistant.ParamSet.WithRequiredPrompter), that prompts the user for
+// option values unless the --no-tui flag has been provided and only when
+// stdin is a terminal.
+func (f *TextualInteractionParameterSet) InteractivePrompter(
+	options ...assistant.InteractivePrompterOptionFn,
+) *assistant.InteractivePrompter {
+	return assistant.NewInteractivePrompter(func() bool {
+		return !f.IsNoTui
+	}, options...)
+}
+
 type CliInteractionParameterSet struct {
 	IsTUI bool
 }
@@ -54,3 +66,23 @@ func (f *CliInteractionParameterSet) BindAll(
 		&parent.Native.IsTUI,
 	)
 }
+
+// RequiredPrompter creates a prompter (see assistant.ParamSet.WithRequiredPrompter)
+// that invokes prompt to supply option values, only when the --tui flag has
+// been provided.
+func (f *CliInteractionParameterSet) RequiredPrompter(prompt assistant.PromptFn) assistant.RequiredPrompter {
+	return assistant.NewRequiredPrompter(func() bool {
+		return f.IsTUI
+	}, prompt)
+}
+
+// InteractivePrompter creates an interactive prompter, that prompts the
+// user for option values when the --tui flag has been provided and stdin
+// is a terminal.
+func (f *CliInteractionParameterSet) InteractivePrompter(
+	options ...assistant.InteractivePrompterOptionFn,
+) *assistant.InteractivePrompter {
+	return assistant.NewInteractivePrompter(func() bool {
+		return f.IsTUI
+	}, options...)
+}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"reflect"
 	"strings"
 
 	"github.com/snivilised/cobrass/src/assistant"
@@ -62,3 +63,76 @@ func resolveNewFlagInfo[T any](usage string, defaultValue T,
 		},
 	)
 }
+
+// familyBinder is satisfied by a pointer to a family, F.
+type familyBinder[F any] interface {
+	*F
+	BindAll(parent *assistant.ParamSet[F], flagSet ...*pflag.FlagSet)
+}
+
+type bindFamilyFn func(host assistant.ParamSetHost, native any, flagSet ...*pflag.FlagSet)
+
+func bindFamily[F any, PF familyBinder[F]](host assistant.ParamSetHost,
+	native any, flagSet ...*pflag.FlagSet,
+) {
+	family, _ := native.(*F)
+	PF(family).BindAll(assistant.Embed(host, family), flagSet...)
+}
+
+var families = map[reflect.Type]bindFamilyFn{
+	reflect.TypeFor[CascadeParameterSet]():            bindFamily[CascadeParameterSet],
+	reflect.TypeFor[FilesFilterParameterSet]():        bindFamily[FilesFilterParameterSet],
+	reflect.TypeFor[FoldersFilterParameterSet]():      bindFamily[FoldersFilterParameterSet],
+	reflect.TypeFor[PolyFilterParameterSet]():         bindFamily[PolyFilterParameterSet],
+	reflect.TypeFor[AlloyFilterParameterSet]():        bindFamily[AlloyFilterParameterSet],
+	reflect.TypeFor[I18nParameterSet]():               bindFamily[I18nParameterSet],
+	reflect.TypeFor[TextualInteractionParameterSet](): bindFamily[TextualInteractionParameterSet],
+	reflect.TypeFor[CliInteractionParameterSet]():     bindFamily[CliInteractionParameterSet],
+	reflect.TypeFor[PreviewParameterSet]():            bindFamily[PreviewParameterSet],
+	reflect.TypeFor[ProfileParameterSet]():            bindFamily[ProfileParameterSet],
+	reflect.TypeFor[SamplingParameterSet]():           bindFamily[SamplingParameterSet],
+	reflect.TypeFor[WorkerPoolParameterSet]():         bindFamily[WorkerPoolParameterSet],
+}
+
+// BindFamilies binds all the families that are fields (embedded or named, by
+// value or by pointer) of the native parameter set of outer, in field order.
+// The flags, mutual exclusions and validators of each family are registered
+// against outer, so a single invocation of outer's Validate validates all
+// families. Nil pointer fields are allocated. Fields that are not exported
+// are ignored.
+func BindFamilies[N any](outer *assistant.ParamSet[N], flagSet ...*pflag.FlagSet) {
+	native := reflect.ValueOf(outer.Native).Elem()
+
+	for i := range native.NumField() {
+		field := native.Type().Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		value := native.Field(i)
+		isPointer := field.Type.Kind() == reflect.Ptr
+		familyType := field.Type
+
+		if isPointer {
+			familyType = field.Type.Elem()
+		}
+
+		bind, found := families[familyType]
+		if !found {
+			continue
+		}
+
+		if isPointer {
+			if value.IsNil() {
+				value.Set(reflect.New(familyType))
+			}
+
+			bind(outer, value.Interface(), flagSet...)
+
+			continue
+		}
+
+		bind(outer, value.Addr().Interface(), flagSet...)
+	}
+}